@@ -0,0 +1,209 @@
+package stream_chat
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+type CheckStatus string
+
+const (
+	CheckStatusOK    CheckStatus = "ok"
+	CheckStatusError CheckStatus = "error"
+)
+
+type CheckSQSResponse struct {
+	Status CheckStatus            `json:"status"` // one of CheckStatus* constants
+	Error  string                 `json:"error"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+type checkSQSRequest struct {
+	SQSURL    string `json:"sqs_url"`
+	SQSKey    string `json:"sqs_key"`
+	SQSSecret string `json:"sqs_secret"`
+}
+
+// CheckSQS validates the given SQS queue URL and credentials, the result of the check is returned in the response.
+func (c *Client) CheckSQS(sqsURL string, key string, secret string) (*CheckSQSResponse, error) {
+	switch {
+	case sqsURL == "":
+		return nil, errors.New("SQS URL is empty")
+	case key == "":
+		return nil, errors.New("SQS key is empty")
+	case secret == "":
+		return nil, errors.New("SQS secret is empty")
+	}
+
+	req := checkSQSRequest{SQSURL: sqsURL, SQSKey: key, SQSSecret: secret}
+
+	var resp CheckSQSResponse
+
+	err := c.makeRequest(http.MethodPost, "check_sqs", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+type CheckSNSResponse struct {
+	Status CheckStatus            `json:"status"` // one of CheckStatus* constants
+	Error  string                 `json:"error"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+type checkSNSRequest struct {
+	SNSTopicARN string `json:"sns_topic_arn"`
+	SNSKey      string `json:"sns_key"`
+	SNSSecret   string `json:"sns_secret"`
+}
+
+// CheckSNS validates the given SNS topic ARN and credentials, the result of the check is returned in the response.
+func (c *Client) CheckSNS(topicARN string, key string, secret string) (*CheckSNSResponse, error) {
+	switch {
+	case topicARN == "":
+		return nil, errors.New("SNS topic ARN is empty")
+	case key == "":
+		return nil, errors.New("SNS key is empty")
+	case secret == "":
+		return nil, errors.New("SNS secret is empty")
+	}
+
+	req := checkSNSRequest{SNSTopicARN: topicARN, SNSKey: key, SNSSecret: secret}
+
+	var resp CheckSNSResponse
+
+	err := c.makeRequest(http.MethodPost, "check_sns", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+type CheckPushRequest struct {
+	MessageID        string `json:"message_id,omitempty"`
+	UserID           string `json:"user_id,omitempty"`
+	ApnTemplate      string `json:"apn_template,omitempty"`
+	FirebaseTemplate string `json:"firebase_template,omitempty"`
+	SkipDevices      bool   `json:"skip_devices,omitempty"` // only render templates, do not check the user's devices
+}
+
+type DeviceError struct {
+	Provider     string `json:"provider"`
+	ErrorMessage string `json:"error_message"`
+}
+
+type CheckPushResponse struct {
+	DeviceErrors             map[string]DeviceError `json:"device_errors"` // keyed by device ID
+	GeneralErrors            []string               `json:"general_errors"`
+	SkipDevices              bool                   `json:"skip_devices"`
+	RenderedApnTemplate      string                 `json:"rendered_apn_template"`
+	RenderedFirebaseTemplate string                 `json:"rendered_firebase_template"`
+}
+
+// CheckPush renders the push templates for the given message and user and reports
+// errors returned by the push providers for the user's devices.
+func (c *Client) CheckPush(req *CheckPushRequest) (*CheckPushResponse, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("check push request is nil")
+	case req.MessageID == "":
+		return nil, errors.New("message ID is empty")
+	}
+
+	var resp CheckPushResponse
+
+	err := c.makeRequest(http.MethodPost, "check_push", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+type EventHookType string
+
+const (
+	EventHookTypeWebhook EventHookType = "webhook"
+	EventHookTypeSQS     EventHookType = "sqs"
+	EventHookTypeSNS     EventHookType = "sns"
+)
+
+// EventHook delivers app events to a webhook endpoint, SQS queue or SNS topic
+type EventHook struct {
+	ID         string        `json:"id,omitempty"`
+	HookType   EventHookType `json:"hook_type"` // one of EventHookType* constants
+	Enabled    bool          `json:"enabled"`
+	EventTypes []EventType   `json:"event_types"` // events to deliver, empty means all events
+
+	WebhookURL string `json:"webhook_url,omitempty"`
+
+	SQSQueueURL string `json:"sqs_queue_url,omitempty"`
+	SQSRegion   string `json:"sqs_region,omitempty"`
+	SQSKey      string `json:"sqs_key,omitempty"`
+	SQSSecret   string `json:"sqs_secret,omitempty"`
+
+	SNSTopicARN string `json:"sns_topic_arn,omitempty"`
+	SNSRegion   string `json:"sns_region,omitempty"`
+	SNSKey      string `json:"sns_key,omitempty"`
+	SNSSecret   string `json:"sns_secret,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+type AppSettings struct {
+	// on update nil keeps the webhook URL, an empty string removes it
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	// on update nil keeps the hooks, otherwise they replace all configured hooks, an empty slice removes them
+	EventHooks []*EventHook `json:"event_hooks,omitempty"`
+}
+
+type appResponse struct {
+	App *AppSettings `json:"app"`
+}
+
+// GetAppSettings returns app settings
+func (c *Client) GetAppSettings() (*AppSettings, error) {
+	var resp appResponse
+
+	err := c.makeRequest(http.MethodGet, "app", nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.App == nil {
+		return nil, errors.New("unexpected error: app response is nil")
+	}
+
+	return resp.App, nil
+}
+
+// UpdateAppSettings updates app settings, only non-nil fields are changed
+func (c *Client) UpdateAppSettings(settings *AppSettings) error {
+	if settings == nil {
+		return errors.New("app settings are nil")
+	}
+
+	for _, hook := range settings.EventHooks {
+		if hook == nil {
+			return errors.New("event hook is nil")
+		}
+		if hook.HookType == "" {
+			return errors.New("event hook type is empty")
+		}
+	}
+
+	// a map rather than settings, so empty values which clear a setting are sent
+	data := map[string]interface{}{}
+	if settings.WebhookURL != nil {
+		data["webhook_url"] = *settings.WebhookURL
+	}
+	if settings.EventHooks != nil {
+		data["event_hooks"] = settings.EventHooks
+	}
+
+	return c.makeRequest(http.MethodPatch, "app", nil, data, nil)
+}
@@ -0,0 +1,106 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CheckSQS(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.CheckSQS("https://foo.com/bar", "key", "secret")
+	mustNoError(t, err, "check sqs")
+
+	assert.Equal(t, CheckStatusError, resp.Status)
+	assert.NotEmpty(t, resp.Error, "error message is set")
+}
+
+func TestClient_CheckSNS(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.CheckSNS("arn:aws:sns:us-east-1:123456789012:sns-topic", "key", "secret")
+	mustNoError(t, err, "check sns")
+
+	assert.Equal(t, CheckStatusError, resp.Status)
+	assert.NotEmpty(t, resp.Error, "error message is set")
+}
+
+func TestClient_CheckPush(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	resp, err := c.CheckPush(&CheckPushRequest{
+		MessageID:   msg.ID,
+		UserID:      serverUser.ID,
+		SkipDevices: true,
+	})
+	mustNoError(t, err, "check push")
+
+	assert.True(t, resp.SkipDevices, "devices are skipped")
+	assert.Empty(t, resp.DeviceErrors, "no device errors")
+}
+
+func TestClient_UpdateAppSettings(t *testing.T) {
+	c := initClient(t)
+
+	settings, err := c.GetAppSettings()
+	mustNoError(t, err, "get app settings")
+
+	// a non-nil slice, so the test hooks are removed when the app had none
+	restore := append([]*EventHook{}, settings.EventHooks...)
+	defer c.UpdateAppSettings(&AppSettings{EventHooks: restore})
+
+	hooks := []*EventHook{
+		{
+			HookType:   EventHookTypeWebhook,
+			Enabled:    true,
+			EventTypes: []EventType{EventMessageNew, EventMessageUpdated},
+			WebhookURL: "https://eu.example.com/webhook",
+		},
+		{
+			HookType:   EventHookTypeWebhook,
+			Enabled:    true,
+			WebhookURL: "https://us.example.com/webhook",
+		},
+	}
+
+	err = c.UpdateAppSettings(&AppSettings{EventHooks: hooks})
+	mustNoError(t, err, "update app settings")
+
+	got, err := c.GetAppSettings()
+	mustNoError(t, err, "get app settings")
+
+	if assert.Len(t, got.EventHooks, len(hooks)) {
+		for _, hook := range got.EventHooks {
+			assert.NotEmpty(t, hook.ID, "hook ID")
+		}
+	}
+}
+
+func TestClient_UpdateAppSettings_clear(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = nil
+		_ = json.Unmarshal(data, &body)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	empty := ""
+	mustNoError(t, c.UpdateAppSettings(&AppSettings{WebhookURL: &empty, EventHooks: []*EventHook{}}), "clear settings")
+	assert.Equal(t, map[string]interface{}{"webhook_url": "", "event_hooks": []interface{}{}}, body, "empty values are sent")
+
+	mustNoError(t, c.UpdateAppSettings(&AppSettings{}), "update nothing")
+	assert.Empty(t, body, "nil settings are not sent")
+}
@@ -0,0 +1,264 @@
+package stream_chat
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Blocklist struct {
+	Name  string   `json:"name"`
+	Words []string `json:"words"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+type blocklistResponse struct {
+	Blocklist *Blocklist `json:"blocklist"`
+}
+
+// CreateBlocklist creates a blocklist of words which can be enabled on channel types
+func (c *Client) CreateBlocklist(name string, words []string) error {
+	switch {
+	case name == "":
+		return errors.New("blocklist name is empty")
+	case len(words) == 0:
+		return errors.New("words are empty")
+	}
+
+	data := Blocklist{Name: name, Words: words}
+
+	return c.makeRequest(http.MethodPost, "blocklists", nil, data, nil)
+}
+
+// GetBlocklist returns the blocklist with given name
+func (c *Client) GetBlocklist(name string) (*Blocklist, error) {
+	if name == "" {
+		return nil, errors.New("blocklist name is empty")
+	}
+
+	p := buildPath("blocklists", name)
+
+	var resp blocklistResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+
+	return resp.Blocklist, err
+}
+
+type listBlocklistsResponse struct {
+	Blocklists []*Blocklist `json:"blocklists"`
+}
+
+// ListBlocklists returns all blocklists of the app
+func (c *Client) ListBlocklists() ([]*Blocklist, error) {
+	var resp listBlocklistsResponse
+
+	err := c.makeRequest(http.MethodGet, "blocklists", nil, nil, &resp)
+
+	return resp.Blocklists, err
+}
+
+// DeleteBlocklist removes the blocklist with given name
+func (c *Client) DeleteBlocklist(name string) error {
+	if name == "" {
+		return errors.New("blocklist name is empty")
+	}
+
+	p := buildPath("blocklists", name)
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+// UpdateBlocklist replaces words of the blocklist with given name
+func (c *Client) UpdateBlocklist(name string, words []string) error {
+	switch {
+	case name == "":
+		return errors.New("blocklist name is empty")
+	case len(words) == 0:
+		return errors.New("words are empty")
+	}
+
+	data := map[string]interface{}{
+		"words": words,
+	}
+
+	p := buildPath("blocklists", name)
+
+	return c.makeRequest(http.MethodPut, p, nil, data, nil)
+}
+
+// BlocklistSync is the outcome of syncing a blocklist
+type BlocklistSync struct {
+	Added   []string // words which were not in the blocklist
+	Removed []string // words which are no longer in the blocklist
+	Hash    string   // hash of the synced word list, equal hashes mean equal lists
+	ETag    string   // ETag of the word list, only set by SyncBlocklistFromURL
+
+	Created bool // the blocklist didn't exist and was created
+	Updated bool // the blocklist was changed
+}
+
+// normalizeWords returns sorted unique words, skipping empty ones
+func normalizeWords(words []string) []string {
+	set := make(map[string]bool, len(words))
+	result := make([]string, 0, len(words))
+
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" || set[w] {
+			continue
+		}
+		set[w] = true
+		result = append(result, w)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// wordsHash hashes normalized words
+func wordsHash(words []string) string {
+	h := sha256.New()
+	for _, w := range words {
+		_, _ = io.WriteString(h, w)
+		_, _ = h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readWords reads one word per line, blank lines and lines starting with # are skipped
+func readWords(r io.Reader) ([]string, error) {
+	var words []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+
+	return words, s.Err()
+}
+
+// SyncBlocklist makes the blocklist with given name contain exactly the words read from r,
+// one word per line, blank lines and lines starting with # are skipped.
+// The blocklist is created if it doesn't exist and is only updated when its words differ.
+func (c *Client) SyncBlocklist(name string, r io.Reader) (*BlocklistSync, error) {
+	if name == "" {
+		return nil, errors.New("blocklist name is empty")
+	}
+
+	words, err := readWords(r)
+	if err != nil {
+		return nil, err
+	}
+
+	words = normalizeWords(words)
+	if len(words) == 0 {
+		return nil, errors.New("words are empty")
+	}
+
+	result := &BlocklistSync{Hash: wordsHash(words)}
+
+	current, err := c.GetBlocklist(name)
+	switch {
+	case isNotFound(err):
+		if err := c.CreateBlocklist(name, words); err != nil {
+			return nil, err
+		}
+		result.Added = words
+		result.Created, result.Updated = true, true
+		return result, nil
+	case err != nil:
+		return nil, err
+	}
+
+	existing := normalizeWords(current.Words)
+	if wordsHash(existing) == result.Hash {
+		return result, nil
+	}
+
+	result.Added, result.Removed = diffWords(existing, words)
+
+	if err := c.UpdateBlocklist(name, words); err != nil {
+		return nil, err
+	}
+	result.Updated = true
+
+	return result, nil
+}
+
+// diffWords returns words of b missing in a and words of a missing in b, both lists must be normalized
+func diffWords(a []string, b []string) (added []string, removed []string) {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || i < len(a) && a[i] < b[j]:
+			removed = append(removed, a[i])
+			i++
+		case i == len(a) || b[j] < a[i]:
+			added = append(added, b[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return added, removed
+}
+
+// SyncBlocklistFromURL syncs the blocklist with the word list downloaded from url, see SyncBlocklist.
+// etag: ETag returned by the previous sync, if the list wasn't changed since then nothing is done
+func (c *Client) SyncBlocklistFromURL(name string, url string, etag string) (*BlocklistSync, error) {
+	switch {
+	case name == "":
+		return nil, errors.New("blocklist name is empty")
+	case url == "":
+		return nil, errors.New("url is empty")
+	}
+
+	r, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if etag != "" {
+		r.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := c.HTTP.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return &BlocklistSync{ETag: etag}, nil
+	case resp.StatusCode >= 300:
+		return nil, fmt.Errorf("download word list: HTTP %s", resp.Status)
+	}
+
+	result, err := c.SyncBlocklist(name, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	result.ETag = resp.Header.Get("ETag")
+
+	return result, nil
+}
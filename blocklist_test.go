@@ -0,0 +1,127 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Blocklist(t *testing.T) {
+	c := initClient(t)
+
+	name := randomString(10)
+
+	err := c.CreateBlocklist(name, []string{"fudge", "heck"})
+	mustNoError(t, err, "create blocklist")
+
+	err = c.UpdateBlocklist(name, []string{"fudge", "heck", "darn"})
+	mustNoError(t, err, "update blocklist")
+
+	list, err := c.GetBlocklist(name)
+	mustNoError(t, err, "get blocklist")
+
+	assert.Equal(t, name, list.Name)
+	assert.ElementsMatch(t, []string{"fudge", "heck", "darn"}, list.Words)
+
+	lists, err := c.ListBlocklists()
+	mustNoError(t, err, "list blocklists")
+
+	var names []string
+	for _, l := range lists {
+		names = append(names, l.Name)
+	}
+	assert.Contains(t, names, name)
+
+	ct := prepareChannelType(t, c)
+	defer c.DeleteChannelType(ct.Name)
+
+	err = c.UpdateChannelType(ct.Name, map[string]interface{}{
+		"blocklist":          name,
+		"blocklist_behavior": BlocklistBehaviorBlock,
+	})
+	mustNoError(t, err, "attach blocklist to channel type")
+
+	got, err := c.GetChannelType(ct.Name)
+	mustNoError(t, err, "get channel type")
+
+	assert.Equal(t, name, got.Blocklist)
+	assert.Equal(t, BlocklistBehaviorBlock, got.BlocklistBehavior)
+
+	mustNoError(t, c.UpdateChannelType(ct.Name, map[string]interface{}{"blocklist": ""}), "detach blocklist")
+	mustNoError(t, c.DeleteBlocklist(name), "delete blocklist")
+}
+
+func TestDiffWords(t *testing.T) {
+	added, removed := diffWords([]string{"a", "c", "d"}, []string{"b", "c", "e"})
+
+	assert.Equal(t, []string{"b", "e"}, added)
+	assert.Equal(t, []string{"a", "d"}, removed)
+}
+
+func TestReadWords(t *testing.T) {
+	words, err := readWords(strings.NewReader("# profanity list\nheck\n\n  fudge \nheck\n"))
+	mustNoError(t, err, "read words")
+
+	assert.Equal(t, []string{"fudge", "heck"}, normalizeWords(words))
+}
+
+func TestClient_SyncBlocklistFromURL(t *testing.T) {
+	var (
+		stored  []string
+		updates int
+	)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/words.txt":
+			if r.Header.Get("If-None-Match") == `"v2"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v2"`)
+			_, _ = w.Write([]byte("heck\nfudge\ndarn\n"))
+		case r.Method == http.MethodGet && stored == nil:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet:
+			resp, _ := json.Marshal(map[string]interface{}{
+				"blocklist": map[string]interface{}{"name": "profanity", "words": stored},
+			})
+			_, _ = w.Write(resp)
+		default:
+			var body struct {
+				Words []string `json:"words"`
+			}
+			data, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &body)
+			stored = body.Words
+			updates++
+			_, _ = w.Write([]byte("{}"))
+		}
+	})
+
+	sync, err := c.SyncBlocklist("profanity", strings.NewReader("heck\nfudge\n"))
+	mustNoError(t, err, "sync new blocklist")
+	assert.True(t, sync.Created)
+	assert.Equal(t, []string{"fudge", "heck"}, sync.Added)
+
+	sync, err = c.SyncBlocklistFromURL("profanity", c.BaseURL+"/words.txt", "")
+	mustNoError(t, err, "sync from url")
+	assert.True(t, sync.Updated)
+	assert.Equal(t, []string{"darn"}, sync.Added)
+	assert.Empty(t, sync.Removed)
+	assert.Equal(t, `"v2"`, sync.ETag)
+
+	sync, err = c.SyncBlocklist("profanity", strings.NewReader("darn\nfudge\nheck\n"))
+	mustNoError(t, err, "sync unchanged blocklist")
+	assert.False(t, sync.Updated)
+
+	sync, err = c.SyncBlocklistFromURL("profanity", c.BaseURL+"/words.txt", `"v2"`)
+	mustNoError(t, err, "sync not modified list")
+	assert.False(t, sync.Updated)
+
+	assert.Equal(t, 2, updates, "blocklist is written only when changed")
+}
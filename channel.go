@@ -2,10 +2,14 @@ package stream_chat
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
-	"path"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/getstream/easyjson"
 )
 
 type ChannelMember struct {
@@ -17,9 +21,15 @@ type ChannelMember struct {
 	InviteAcceptedAt *time.Time `json:"invite_accepted_at,omitempty"`
 	InviteRejectedAt *time.Time `json:"invite_rejected_at,omitempty"`
 	Role             string     `json:"role,omitempty"`
+	ChannelRole      string     `json:"channel_role,omitempty"` // ie "channel_member" or "channel_moderator"
+	ArchivedAt       *time.Time `json:"archived_at,omitempty"`  // nil if the member didn't archive the channel, see Channel.Archive
+	PinnedAt         *time.Time `json:"pinned_at,omitempty"`    // nil if the member didn't pin the channel, see Channel.Pin
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+
+	// custom fields of the membership, see Channel.PartialUpdateMember
+	ExtraData map[string]interface{} `json:"-,extra"`
 }
 
 type Channel struct {
@@ -29,8 +39,11 @@ type Channel struct {
 
 	Config ChannelConfig `json:"config"`
 
-	CreatedBy *User `json:"created_by"`
-	Frozen    bool  `json:"frozen"`
+	CreatedBy *User  `json:"created_by"`
+	Frozen    bool   `json:"frozen"`
+	Disabled  bool   `json:"disabled"`       // no reads or writes are allowed, see Disable
+	Team      string `json:"team,omitempty"` // team the channel belongs to in multi-tenant apps
+	Cooldown  int    `json:"cooldown"`       // slow mode: seconds a member has to wait between messages, 0 if off
 
 	MemberCount int              `json:"member_count"`
 	Members     []*ChannelMember `json:"members"`
@@ -38,26 +51,99 @@ type Channel struct {
 	Messages []*Message `json:"messages"`
 	Read     []*User    `json:"read"`
 
+	Watchers     []*User `json:"watchers"`      // users watching the channel, up to the query's watcher limit
+	WatcherCount int     `json:"watcher_count"` // number of users watching the channel
+
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	LastMessageAt time.Time `json:"last_message_at"`
 
+	// any other fields the user wants to attach to a channel
+	ExtraData map[string]interface{} `json:"-,extra"`
+	// custom fields decoded into the type registered with RegisterChannelDataType, in addition to ExtraData
+	Data interface{} `json:"-"`
+
 	client *Client
+	mu     *sync.RWMutex // guards state updates by requests, see Snapshot
+}
+
+// channelsMu guards channels which were not created by a client, ie literals in tests
+var channelsMu sync.RWMutex
+
+func (c *Client) newChannel(chanType string, chanID string) *Channel {
+	return &Channel{
+		Type:   chanType,
+		ID:     chanID,
+		client: c,
+		mu:     &sync.RWMutex{},
+	}
+}
+
+func (ch *Channel) locker() *sync.RWMutex {
+	if ch.mu == nil {
+		return &channelsMu
+	}
+	return ch.mu
+}
+
+// ChannelInput describes a channel to create
+type ChannelInput struct {
+	Type        string
+	ID          string
+	CreatedByID string                 // ID of the user creating the channel
+	Data        map[string]interface{} // additional channel data, see CreateChannel
+}
+
+// NewChannelInput returns a channel input with the normalized ID: trimmed and lowercased.
+// Returns an error if the type is empty or the ID is not valid, see ValidateChannelID.
+func NewChannelInput(chanType string, chanID string) (*ChannelInput, error) {
+	chanID = strings.ToLower(strings.TrimSpace(chanID))
+
+	if chanType == "" {
+		return nil, errors.New("channel type is empty")
+	}
+
+	if err := ValidateChannelID(chanID); err != nil {
+		return nil, err
+	}
+
+	return &ChannelInput{Type: chanType, ID: chanID}, nil
 }
 
 type queryResponse struct {
-	Channel  *Channel         `json:"channel,omitempty"`
-	Messages []*Message       `json:"messages,omitempty"`
-	Members  []*ChannelMember `json:"members,omitempty"`
-	Read     []*User          `json:"read,omitempty"`
+	// kept raw so custom fields can also be decoded into the registered data type, see updateChannel
+	Channel  easyjson.RawMessage `json:"channel,omitempty"`
+	Messages []*Message          `json:"messages,omitempty"`
+	Members  []*ChannelMember    `json:"members,omitempty"`
+	Read     []*User             `json:"read,omitempty"`
+
+	Watchers     []*User `json:"watchers,omitempty"`
+	WatcherCount int     `json:"watcher_count,omitempty"`
 }
 
-func (q queryResponse) updateChannel(ch *Channel) {
-	if q.Channel != nil {
-		// save client pointer but update channel information
-		client := ch.client
-		*ch = *q.Channel
-		ch.client = client
+// updateChannel swaps the response state into ch under its lock, so readers using Snapshot never see a partial update
+func (q queryResponse) updateChannel(ch *Channel) error {
+	var fresh *Channel
+
+	if q.Channel.IsDefined() && string(q.Channel) != "null" {
+		fresh = &Channel{}
+		if err := easyjson.Unmarshal(q.Channel, fresh); err != nil {
+			return err
+		}
+
+		// save client and mutex pointers but update channel information
+		fresh.client, fresh.mu = ch.client, ch.mu
+		if err := fresh.decodeData(q.Channel); err != nil {
+			return err
+		}
+	}
+
+	mu := ch.locker()
+	mu.Lock()
+	defer mu.Unlock()
+
+	if fresh != nil {
+		*ch = *fresh
 	}
 
 	if q.Members != nil {
@@ -69,6 +155,41 @@ func (q queryResponse) updateChannel(ch *Channel) {
 	if q.Read != nil {
 		ch.Read = q.Read
 	}
+	if q.Watchers != nil {
+		ch.Watchers = q.Watchers
+	}
+	if q.WatcherCount != 0 {
+		ch.WatcherCount = q.WatcherCount
+	}
+
+	return nil
+}
+
+// QueryWatchers returns a page of the users watching the channel and the number of all watchers,
+// ie for a "X people viewing" badge. The channel state is not changed.
+func (ch *Channel) QueryWatchers(limit int, offset int) ([]*User, int, error) {
+	switch {
+	case limit < 0:
+		return nil, 0, errors.New("limit is negative")
+	case offset < 0:
+		return nil, 0, errors.New("offset is negative")
+	}
+
+	payload := map[string]interface{}{
+		"state":    true,
+		"watchers": map[string]interface{}{"limit": limit, "offset": offset},
+	}
+
+	p := ch.path("query")
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, payload, &resp)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Watchers, resp.WatcherCount, nil
 }
 
 // query makes request to channel api and updates channel internal state
@@ -85,11 +206,17 @@ func (ch *Channel) query(options map[string]interface{}, data map[string]interfa
 		data = map[string]interface{}{}
 	}
 
-	data["created_by"] = map[string]interface{}{"id": ch.CreatedBy.ID}
+	if ch.CreatedBy != nil {
+		data["created_by"] = map[string]interface{}{"id": ch.CreatedBy.ID}
+	}
 
 	payload["data"] = data
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
+	p := ch.path("query")
+	if ch.ID == "" {
+		// distinct channel, the ID is generated from the members
+		p = buildPath("channels", ch.Type, "query")
+	}
 
 	var resp queryResponse
 
@@ -98,42 +225,294 @@ func (ch *Channel) query(options map[string]interface{}, data map[string]interfa
 		return err
 	}
 
-	resp.updateChannel(ch)
-
-	return nil
+	return resp.updateChannel(ch)
 }
 
 // Update edits the channel's custom properties
 //
-// options: the object to update the custom properties of this channel with
-// message: optional update message
-func (ch *Channel) Update(options map[string]interface{}, message string) error {
+// data: the object to update the custom properties of this channel with, ie {"name": "Fellowship"}
+// message: optional message sent to the channel along with the update, ie to announce the new name
+func (ch *Channel) Update(data map[string]interface{}, message *Message) error {
 	payload := map[string]interface{}{
-		"data":    options,
-		"message": message,
+		"data": data,
+	}
+
+	if message != nil {
+		if message.User == nil || message.User.ID == "" {
+			return errors.New("message user ID is empty")
+		}
+		payload["message"] = message.toRequest().Message
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+	p := ch.path()
 
 	return ch.client.makeRequest(http.MethodPost, p, nil, payload, nil)
 }
 
-// Delete removes the channel. Messages are permanently removed.
-func (ch *Channel) Delete() error {
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+// PartialUpdate sets and unsets the channel's custom properties, other properties are kept,
+// so concurrent updates of different properties don't overwrite each other
+//
+// set: properties to set, ie {"topic": "lunch", "color": "blue"}
+// unset: names of properties to remove
+func (ch *Channel) PartialUpdate(set map[string]interface{}, unset []string) error {
+	return ch.partialUpdate(set, unset, nil)
+}
+
+func (ch *Channel) partialUpdate(set map[string]interface{}, unset []string, message *Message) error {
+	if len(set) == 0 && len(unset) == 0 {
+		return errors.New("set and unset are empty")
+	}
+
+	data := map[string]interface{}{
+		"set":   set,
+		"unset": unset,
+	}
+
+	if message != nil {
+		if message.User == nil || message.User.ID == "" {
+			return errors.New("message user ID is empty")
+		}
+		data["message"] = message.toRequest().Message
+	}
+
+	p := ch.path()
+
+	return ch.client.makeRequest(http.MethodPatch, p, nil, data, nil)
+}
+
+// Freeze stops members from sending messages and reactions to the channel, ie during an incident.
+// message is an optional message sent to the channel, ie "this channel is locked", may be nil
+func (ch *Channel) Freeze(message *Message) error {
+	return ch.setFrozen(true, message)
+}
+
+// Unfreeze lets members send messages and reactions to the channel again, see Freeze.
+// message is an optional message sent to the channel, may be nil
+func (ch *Channel) Unfreeze(message *Message) error {
+	return ch.setFrozen(false, message)
+}
+
+// Disable takes the channel fully offline: unlike Freeze, members can neither send nor read messages,
+// ie for a legal takedown. The channel and its messages are kept, see Enable.
+func (ch *Channel) Disable() error {
+	return ch.setDisabled(true)
+}
+
+// Enable brings a disabled channel back online, see Disable
+func (ch *Channel) Enable() error {
+	return ch.setDisabled(false)
+}
+
+func (ch *Channel) setDisabled(disabled bool) error {
+	if err := ch.partialUpdate(map[string]interface{}{"disabled": disabled}, nil, nil); err != nil {
+		return err
+	}
+
+	ch.locker().Lock()
+	ch.Disabled = disabled
+	ch.locker().Unlock()
+
+	return nil
+}
+
+func (ch *Channel) setFrozen(frozen bool, message *Message) error {
+	if err := ch.partialUpdate(map[string]interface{}{"frozen": frozen}, nil, message); err != nil {
+		return err
+	}
+
+	ch.locker().Lock()
+	ch.Frozen = frozen
+	ch.locker().Unlock()
+
+	return nil
+}
+
+const maxCooldown = 120
+
+// EnableSlowMode makes members wait the given number of seconds, up to 120, between sending messages,
+// ie to throttle a livestream chat during spikes. Moderators and admins are not throttled.
+func (ch *Channel) EnableSlowMode(seconds int) error {
+	if seconds < 1 || seconds > maxCooldown {
+		return fmt.Errorf("cooldown must be between 1 and %d seconds, got %d", maxCooldown, seconds)
+	}
+
+	if err := ch.PartialUpdate(map[string]interface{}{"cooldown": seconds}, nil); err != nil {
+		return err
+	}
+
+	ch.locker().Lock()
+	ch.Cooldown = seconds
+	ch.locker().Unlock()
+
+	return nil
+}
+
+// DisableSlowMode lets members send messages without waiting, see EnableSlowMode
+func (ch *Channel) DisableSlowMode() error {
+	if err := ch.PartialUpdate(map[string]interface{}{"cooldown": 0}, nil); err != nil {
+		return err
+	}
+
+	ch.locker().Lock()
+	ch.Cooldown = 0
+	ch.locker().Unlock()
+
+	return nil
+}
+
+type channelMemberResponse struct {
+	ChannelMember *ChannelMember `json:"channel_member"`
+}
+
+// PartialUpdateMember sets and unsets custom properties of the membership of the user with given ID,
+// ie {"notification_level": "mentions"}, so per-channel data is not stored on the user.
+// unset: names of properties to remove
+func (ch *Channel) PartialUpdateMember(userID string, set map[string]interface{}, unset []string) (*ChannelMember, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case len(set) == 0 && len(unset) == 0:
+		return nil, errors.New("set and unset are empty")
+	}
+
+	data := map[string]interface{}{
+		"set":   set,
+		"unset": unset,
+	}
+
+	p := ch.path("member", userID)
+
+	var resp channelMemberResponse
+
+	err := ch.client.makeRequest(http.MethodPatch, p, nil, data, &resp)
+
+	return resp.ChannelMember, err
+}
+
+// Archive archives the channel for the member with given ID, so it can be excluded from their channel list
+// with QueryChannels filter {"archived": false}
+func (ch *Channel) Archive(userID string) error {
+	_, err := ch.PartialUpdateMember(userID, map[string]interface{}{"archived": true}, nil)
+	return err
+}
+
+// Unarchive restores the channel archived by the member with given ID, see Archive
+func (ch *Channel) Unarchive(userID string) error {
+	_, err := ch.PartialUpdateMember(userID, map[string]interface{}{"archived": false}, nil)
+	return err
+}
+
+// Pin pins the channel to the top of the channel list of the member with given ID,
+// pinned channels can be filtered with QueryChannels filter {"pinned": true}
+func (ch *Channel) Pin(userID string) error {
+	_, err := ch.PartialUpdateMember(userID, map[string]interface{}{"pinned": true}, nil)
+	return err
+}
+
+// Unpin removes the pin of the channel for the member with given ID, see Pin
+func (ch *Channel) Unpin(userID string) error {
+	_, err := ch.PartialUpdateMember(userID, map[string]interface{}{"pinned": false}, nil)
+	return err
+}
+
+// Delete removes the channel.
+// hard: permanently remove the channel and its messages, otherwise the channel is soft deleted and can be recovered
+func (ch *Channel) Delete(hard bool) error {
+	p := ch.path()
+
+	var params url.Values
+	if hard {
+		params = url.Values{"hard_delete": {"true"}}
+	}
+
+	return ch.client.makeRequest(http.MethodDelete, p, params, nil, nil)
+}
+
+// TruncateOptions controls how the channel history is truncated
+type TruncateOptions struct {
+	// HardDelete removes the messages permanently instead of soft deleting them
+	HardDelete bool
+	// SkipPush disables push notifications for the system message
+	SkipPush bool
+	// TruncatedAt removes only messages created before this time, zero means now
+	TruncatedAt time.Time
+	// Message is an optional message sent to the channel after truncation, ie "history cleared"
+	Message *Message
+}
+
+// Truncate removes all messages from the channel, options may be nil
+func (ch *Channel) Truncate(options *TruncateOptions) error {
+	var data map[string]interface{}
+
+	if options != nil {
+		data = map[string]interface{}{}
+
+		if options.HardDelete {
+			data["hard_delete"] = true
+		}
+		if options.SkipPush {
+			data["skip_push"] = true
+		}
+		if !options.TruncatedAt.IsZero() {
+			data["truncated_at"] = options.TruncatedAt
+		}
+		if options.Message != nil {
+			if options.Message.User == nil || options.Message.User.ID == "" {
+				return errors.New("message user ID is empty")
+			}
+			data["message"] = options.Message.toRequest().Message
+		}
+	}
+
+	p := ch.path("truncate")
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+}
+
+// Hide hides the channel from QueryChannels for the user until a new message is added,
+// clearHistory also hides the messages sent before, ie to archive a conversation
+func (ch *Channel) Hide(userID string, clearHistory bool) error {
+	if userID == "" {
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"user_id":       userID,
+		"clear_history": clearHistory,
+	}
+
+	p := ch.path("hide")
 
-	return ch.client.makeRequest(http.MethodDelete, p, nil, nil, nil)
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
-// Truncate removes all messages from the channel
-func (ch *Channel) Truncate() error {
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "truncate")
+// Show shows a previously hidden channel for the user again
+func (ch *Channel) Show(userID string) error {
+	if userID == "" {
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"user_id": userID,
+	}
 
-	return ch.client.makeRequest(http.MethodPost, p, nil, nil, nil)
+	p := ch.path("show")
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
-// AddMembers adds members with given user IDs to the channel
-func (ch *Channel) AddMembers(userIDs ...string) error {
+// AddMemberOptions controls how members are added to the channel
+type AddMemberOptions struct {
+	// HideHistory hides the messages sent before the members were added from them
+	HideHistory bool
+	// Message is an optional message sent to the channel, ie "frodo joined"
+	Message *Message
+	// MemberRole is the channel role of the added members, ie "channel_moderator"; empty for the default role
+	MemberRole string
+}
+
+// AddMembers adds members with given user IDs to the channel and refreshes the channel members, options may be nil
+func (ch *Channel) AddMembers(userIDs []string, options *AddMemberOptions) error {
 	if len(userIDs) == 0 {
 		return errors.New("user IDs are empty")
 	}
@@ -142,13 +521,40 @@ func (ch *Channel) AddMembers(userIDs ...string) error {
 		"add_members": userIDs,
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+	if options != nil {
+		if options.HideHistory {
+			data["hide_history"] = true
+		}
+		if options.Message != nil {
+			if options.Message.User == nil || options.Message.User.ID == "" {
+				return errors.New("message user ID is empty")
+			}
+			data["message"] = options.Message.toRequest().Message
+		}
+		if options.MemberRole != "" {
+			members := make([]map[string]interface{}, len(userIDs))
+			for i, id := range userIDs {
+				members[i] = map[string]interface{}{"user_id": id, "channel_role": options.MemberRole}
+			}
+			data["add_members"] = members
+		}
+	}
 
-	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+	p := ch.path()
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, data, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resp.updateChannel(ch)
 }
 
-//  RemoveMembers deletes members with given IDs from the channel
-func (ch *Channel) RemoveMembers(userIDs ...string) error {
+// RemoveMembers deletes members with given IDs from the channel and refreshes the channel members.
+// message is an optional message sent to the channel, ie "frodo was removed", may be nil
+func (ch *Channel) RemoveMembers(userIDs []string, message *Message) error {
 	if len(userIDs) == 0 {
 		return errors.New("user IDs are empty")
 	}
@@ -157,7 +563,14 @@ func (ch *Channel) RemoveMembers(userIDs ...string) error {
 		"remove_members": userIDs,
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+	if message != nil {
+		if message.User == nil || message.User.ID == "" {
+			return errors.New("message user ID is empty")
+		}
+		data["message"] = message.toRequest().Message
+	}
+
+	p := ch.path()
 
 	var resp queryResponse
 
@@ -166,9 +579,46 @@ func (ch *Channel) RemoveMembers(userIDs ...string) error {
 		return err
 	}
 
-	resp.updateChannel(ch)
+	return resp.updateChannel(ch)
+}
 
-	return nil
+// RoleAssignment sets the channel role of a member, see AssignRoles
+type RoleAssignment struct {
+	UserID      string `json:"user_id"`
+	ChannelRole string `json:"channel_role"` // ie "channel_member", "channel_moderator" or a custom role
+}
+
+// AssignRoles sets the channel roles of the members and refreshes the channel members
+func (ch *Channel) AssignRoles(assignments []*RoleAssignment) error {
+	if len(assignments) == 0 {
+		return errors.New("assignments are empty")
+	}
+
+	for _, a := range assignments {
+		switch {
+		case a == nil:
+			return errors.New("assignment is nil")
+		case a.UserID == "":
+			return errors.New("assignment user ID is empty")
+		case a.ChannelRole == "":
+			return fmt.Errorf("channel role of user %s is empty", a.UserID)
+		}
+	}
+
+	data := map[string]interface{}{
+		"assign_roles": assignments,
+	}
+
+	p := ch.path()
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, data, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resp.updateChannel(ch)
 }
 
 // AddModerators adds moderators with given IDs to the channel
@@ -181,12 +631,12 @@ func (ch *Channel) AddModerators(userIDs ...string) error {
 		"add_moderators": userIDs,
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+	p := ch.path()
 
 	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
-// DemoteModerators moderators with given IDs from the channel
+// DemoteModerators demotes moderators with given IDs to regular members of the channel
 func (ch *Channel) DemoteModerators(userIDs ...string) error {
 	if len(userIDs) == 0 {
 		return errors.New("user IDs are empty")
@@ -196,26 +646,100 @@ func (ch *Channel) DemoteModerators(userIDs ...string) error {
 		"demote_moderators": userIDs,
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+	p := ch.path()
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+}
+
+// InviteMembers invites users with given IDs to the channel,
+// they become members once they accept the invite, see AcceptInvite
+func (ch *Channel) InviteMembers(userIDs ...string) error {
+	if len(userIDs) == 0 {
+		return errors.New("user IDs are empty")
+	}
+
+	data := map[string]interface{}{
+		"invites": userIDs,
+	}
+
+	p := ch.path()
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+}
+
+// AcceptInvite accepts the invite to the channel on behalf of the user with given ID
+// message is an optional message sent to the channel, ie "frodo joined", may be nil
+func (ch *Channel) AcceptInvite(userID string, message *Message) error {
+	return ch.answerInvite(userID, "accept_invite", message)
+}
+
+// RejectInvite rejects the invite to the channel on behalf of the user with given ID
+// message is an optional message sent to the channel, may be nil
+func (ch *Channel) RejectInvite(userID string, message *Message) error {
+	return ch.answerInvite(userID, "reject_invite", message)
+}
+
+func (ch *Channel) answerInvite(userID string, answer string, message *Message) error {
+	if userID == "" {
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		answer:    true,
+		"user_id": userID,
+	}
+
+	if message != nil {
+		if message.User == nil || message.User.ID == "" {
+			return errors.New("message user ID is empty")
+		}
+		data["message"] = message.toRequest().Message
+	}
+
+	p := ch.path()
 
 	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
-//  MarkRead send the mark read event for user with given ID, only works if the `read_events` setting is enabled
-//  options: additional data, ie {"messageID": last_messageID}
-func (ch *Channel) MarkRead(userID string, options map[string]interface{}) error {
+// MarkRead marks the channel messages as read for user with given ID, up to and including the message
+// with given messageID, or all of them if messageID is empty.
+// Only works if the `read_events` setting is enabled.
+func (ch *Channel) MarkRead(userID string, messageID string) error {
+	if userID == "" {
+		return errors.New("user ID must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"user": map[string]interface{}{"id": userID},
+	}
+
+	if messageID != "" {
+		data["message_id"] = messageID
+	}
+
+	p := ch.path("read")
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+}
+
+// MarkUnread marks the channel messages as unread for user with given ID,
+// starting from and including the message with given messageID
+func (ch *Channel) MarkUnread(userID string, messageID string) error {
 	switch {
 	case userID == "":
-		return errors.New("user ID must be not empty")
-	case options == nil:
-		options = map[string]interface{}{}
+		return errors.New("user ID is empty")
+	case messageID == "":
+		return errors.New("message ID is empty")
 	}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "read")
+	data := map[string]interface{}{
+		"user_id":    userID,
+		"message_id": messageID,
+	}
 
-	options["user"] = map[string]interface{}{"id": userID}
+	p := ch.path("unread")
 
-	return ch.client.makeRequest(http.MethodPost, p, nil, options, nil)
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
 // BanUser bans target user ID from this channel
@@ -253,22 +777,21 @@ func (ch *Channel) UnBanUser(targetID string, options map[string]string) error {
 }
 
 // CreateChannel creates new channel of given type and id or returns already created one
+// data: additional channel data, ie {"members": userIDs, "team": "red"}
+// With an empty chanID, the distinct channel of the members is created or returned,
+// ie for 1:1 direct messages; "members" data is required then and the generated ID is set on the channel.
 func (c *Client) CreateChannel(chanType string, chanID string, userID string, data map[string]interface{}) (*Channel, error) {
 	switch {
 	case chanType == "":
 		return nil, errors.New("channel type is empty")
-	case chanID == "":
-		return nil, errors.New("channel ID is empty")
+	case chanID == "" && !hasMembers(data):
+		return nil, errors.New("channel ID is empty and members are not set")
 	case userID == "":
 		return nil, errors.New("user ID is empty")
 	}
 
-	ch := &Channel{
-		Type:      chanType,
-		ID:        chanID,
-		client:    c,
-		CreatedBy: &User{ID: userID},
-	}
+	ch := c.newChannel(chanType, chanID)
+	ch.CreatedBy = &User{ID: userID}
 
 	options := map[string]interface{}{
 		"watch":    false,
@@ -281,6 +804,81 @@ func (c *Client) CreateChannel(chanType string, chanID string, userID string, da
 	return ch, err
 }
 
+// hasMembers reports whether channel data has a non-empty member list
+func hasMembers(data map[string]interface{}) bool {
+	switch members := data["members"].(type) {
+	case []string:
+		return len(members) > 0
+	case []interface{}:
+		return len(members) > 0
+	}
+	return false
+}
+
+const maxChannelsPerDelete = 100
+
+// DeleteChannels starts deletion of up to 100 channels with given cids, ie "messaging:general",
+// and returns the ID of the deletion task, see WaitForTask.
+// hardDelete: permanently remove the channels and their messages, see Channel.Delete
+func (c *Client) DeleteChannels(cids []string, hardDelete bool) (string, error) {
+	switch {
+	case len(cids) == 0:
+		return "", errors.New("channel cids are empty")
+	case len(cids) > maxChannelsPerDelete:
+		return "", fmt.Errorf("too many channel cids: %d, max is %d", len(cids), maxChannelsPerDelete)
+	}
+
+	data := map[string]interface{}{
+		"cids":        cids,
+		"hard_delete": hardDelete,
+	}
+
+	var resp taskResponse
+
+	err := c.makeRequest(http.MethodPost, "channels/delete", nil, data, &resp)
+
+	return resp.TaskID, err
+}
+
+// EnsureChannelResult is the outcome of EnsureChannels for one input
+type EnsureChannelResult struct {
+	Input   *ChannelInput
+	Channel *Channel // nil on error
+	Err     error    `json:"-"`
+}
+
+// EnsureChannels gets or creates the channels, see CreateChannel, and returns a result per input
+// in the order of inputs. Failure of one channel doesn't stop the others.
+// Requests are throttled by client's BulkThrottle.
+func (c *Client) EnsureChannels(inputs []*ChannelInput) []*EnsureChannelResult {
+	results := make([]*EnsureChannelResult, len(inputs))
+
+	_ = c.BulkThrottle.run(len(inputs), func(i int) error {
+		in := inputs[i]
+		r := &EnsureChannelResult{Input: in}
+
+		if in == nil {
+			r.Err = errors.New("channel input is nil")
+		} else {
+			// CreateChannel modifies data, inputs may share it
+			data := make(map[string]interface{}, len(in.Data))
+			for k, v := range in.Data {
+				data[k] = v
+			}
+
+			r.Channel, r.Err = c.CreateChannel(in.Type, in.ID, in.CreatedByID, data)
+			if r.Err != nil {
+				r.Channel = nil
+			}
+		}
+
+		results[i] = r
+		return nil
+	})
+
+	return results
+}
+
 // todo: cleanup this
 func (ch *Channel) refresh() error {
 	options := map[string]interface{}{
@@ -293,3 +891,56 @@ func (ch *Channel) refresh() error {
 
 	return err
 }
+
+// Refresh re-queries the channel state and swaps it in at once, so it is safe to call
+// while other goroutines read the channel through Snapshot.
+// On error the current state is kept.
+func (ch *Channel) Refresh() error {
+	fresh := ch.client.newChannel(ch.Type, ch.ID)
+
+	if err := fresh.refresh(); err != nil {
+		return err
+	}
+
+	mu := ch.locker()
+	mu.Lock()
+	defer mu.Unlock()
+
+	fresh.mu = ch.mu
+	*ch = *fresh
+
+	return nil
+}
+
+// Snapshot returns a copy of the channel state which is not affected by later calls to Refresh.
+func (ch *Channel) Snapshot() *Channel {
+	mu := ch.locker()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	snapshot := *ch
+	return &snapshot
+}
+
+// SharedChannels returns channels where both given users are members.
+// chanType: optional channel type to limit results to, ie "messaging"
+func (c *Client) SharedChannels(userA string, userB string, chanType string) ([]*Channel, error) {
+	switch {
+	case userA == "":
+		return nil, errors.New("first user ID is empty")
+	case userB == "":
+		return nil, errors.New("second user ID is empty")
+	}
+
+	filter := map[string]interface{}{
+		"$and": []map[string]interface{}{
+			{"members": map[string]interface{}{"$in": []string{userA}}},
+			{"members": map[string]interface{}{"$in": []string{userB}}},
+		},
+	}
+	if chanType != "" {
+		filter["type"] = chanType
+	}
+
+	return c.QueryChannels(&QueryOption{Filter: filter}, &SortOption{Field: "last_message_at", Direction: -1})
+}
@@ -0,0 +1,185 @@
+package stream_chat
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	archivePollInterval = 5 * time.Second
+	archiveTimeout      = 10 * time.Minute
+)
+
+// ArchiveState is the progress of ArchiveAndDeleteChannel, persist it to resume an interrupted archive
+type ArchiveState struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+
+	TaskID  string `json:"task_id,omitempty"` // export task
+	URL     string `json:"url,omitempty"`     // export file
+	SHA256  string `json:"sha256,omitempty"`  // hex hash of the stored export file
+	Deleted bool   `json:"deleted,omitempty"` // channel is deleted, the archive is done
+}
+
+type ArchiveOptions struct {
+	// Store persists the export file and returns hex SHA-256 of the stored data, required.
+	// The channel is only deleted if it equals the hash of the downloaded file.
+	Store func(state *ArchiveState, export io.Reader) (sha256 string, err error) `json:"-"`
+
+	// Checkpoint is called after every step with the new state, optional
+	Checkpoint func(state *ArchiveState) error `json:"-"`
+
+	// State of an interrupted archive to resume, optional
+	State *ArchiveState
+
+	HardDelete bool // permanently remove the channel and its messages once archived, see Channel.Delete
+
+	PollInterval    time.Duration // export task poll interval, 5 seconds by default
+	Timeout         time.Duration // export task timeout, 10 minutes by default
+	DownloadTimeout time.Duration // export file download timeout, none by default as exports can be large
+}
+
+// ArchiveAndDeleteChannel exports the channel, stores the export file and deletes the channel
+// once the stored file is verified. Every step is checkpointed, an interrupted archive is resumed
+// by passing the last state in options, completed steps are not repeated.
+func (c *Client) ArchiveAndDeleteChannel(chanType string, chanID string, opts *ArchiveOptions) (*ArchiveState, error) {
+	switch {
+	case chanType == "":
+		return nil, errors.New("channel type is empty")
+	case chanID == "":
+		return nil, errors.New("channel ID is empty")
+	case opts == nil || opts.Store == nil:
+		return nil, errors.New("archive store is not set")
+	case opts.State != nil && (opts.State.Type != chanType || opts.State.ID != chanID):
+		return nil, errors.New("archive state belongs to another channel")
+	}
+
+	state := &ArchiveState{Type: chanType, ID: chanID}
+	if opts.State != nil {
+		*state = *opts.State
+	}
+
+	checkpoint := func() error {
+		if opts.Checkpoint == nil {
+			return nil
+		}
+		return opts.Checkpoint(state)
+	}
+
+	if state.TaskID == "" {
+		taskID, err := c.ExportChannels([]*ExportChannelRequest{{Type: chanType, ID: chanID}}, nil)
+		if err != nil {
+			return state, err
+		}
+
+		state.TaskID = taskID
+		if err := checkpoint(); err != nil {
+			return state, err
+		}
+	}
+
+	if state.URL == "" {
+		url, err := c.waitForExport(state.TaskID, opts)
+		if err != nil {
+			return state, err
+		}
+
+		state.URL = url
+		if err := checkpoint(); err != nil {
+			return state, err
+		}
+	}
+
+	if state.SHA256 == "" {
+		hash, err := c.storeExport(state, opts.Store, opts.DownloadTimeout)
+		if err != nil {
+			return state, err
+		}
+
+		state.SHA256 = hash
+		if err := checkpoint(); err != nil {
+			return state, err
+		}
+	}
+
+	if !state.Deleted {
+		// not found when resuming after the deletion succeeded but its checkpoint failed
+		if err := c.newChannel(chanType, chanID).Delete(opts.HardDelete); err != nil && !isNotFound(err) {
+			return state, err
+		}
+
+		state.Deleted = true
+		if err := checkpoint(); err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+// waitForExport waits for the export task and returns the URL of the export file
+func (c *Client) waitForExport(taskID string, opts *ArchiveOptions) (string, error) {
+	interval, timeout := opts.PollInterval, opts.Timeout
+	if interval <= 0 {
+		interval = archivePollInterval
+	}
+	if timeout <= 0 {
+		timeout = archiveTimeout
+	}
+
+	task, err := c.WaitForTask(taskID, interval, timeout)
+	if err != nil {
+		return "", err
+	}
+
+	url, _ := task.Result["url"].(string)
+	if url == "" {
+		return "", fmt.Errorf("export task %s has no file URL", taskID)
+	}
+
+	return url, nil
+}
+
+// storeExport downloads the export file into the store and verifies the stored data hash.
+// The download doesn't use the client's API timeout, which is too short for large files.
+func (c *Client) storeExport(state *ArchiveState, store func(*ArchiveState, io.Reader) (string, error), timeout time.Duration) (string, error) {
+	download := http.Client{Timeout: timeout}
+	if c.HTTP != nil {
+		download = *c.HTTP
+		download.Timeout = timeout
+	}
+
+	resp, err := download.Get(state.URL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("download export: HTTP %s", resp.Status)
+	}
+
+	h := sha256.New()
+
+	stored, err := store(state, io.TeeReader(resp.Body, h))
+	if err != nil {
+		return "", err
+	}
+
+	// drain what the store didn't read, so the hash covers the whole file
+	if _, err := io.Copy(h, resp.Body); err != nil {
+		return "", err
+	}
+
+	downloaded := hex.EncodeToString(h.Sum(nil))
+	if stored != downloaded {
+		return "", fmt.Errorf("stored export hash %s doesn't match downloaded %s", stored, downloaded)
+	}
+
+	return downloaded, nil
+}
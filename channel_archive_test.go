@@ -0,0 +1,138 @@
+package stream_chat
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ArchiveAndDeleteChannel(t *testing.T) {
+	const export = `{"channel":{"cid":"messaging:general"},"messages":[]}`
+
+	calls := map[string]int{}
+
+	var c *Client
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method+" "+r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/export_channels":
+			_, _ = w.Write([]byte(`{"task_id":"task-1"}`))
+		case "/tasks/task-1":
+			_, _ = w.Write([]byte(`{"task_id":"task-1","status":"completed","result":{"url":"` + c.BaseURL + `/export.json"}}`))
+		case "/export.json":
+			_, _ = w.Write([]byte(export))
+		default:
+			_, _ = w.Write([]byte("{}"))
+		}
+	})
+
+	var (
+		stored     []byte
+		checkpoint *ArchiveState
+	)
+
+	opts := &ArchiveOptions{
+		Store: func(state *ArchiveState, r io.Reader) (string, error) {
+			return "", errors.New("storage is down")
+		},
+		Checkpoint: func(state *ArchiveState) error {
+			cp := *state
+			checkpoint = &cp
+			return nil
+		},
+	}
+
+	_, err := c.ArchiveAndDeleteChannel("messaging", "general", opts)
+	mustError(t, err, "store fails")
+	assert.Equal(t, c.BaseURL+"/export.json", checkpoint.URL, "export is checkpointed")
+	assert.Equal(t, 0, calls["DELETE /channels/messaging/general"], "channel is kept")
+
+	opts.Store = func(state *ArchiveState, r io.Reader) (string, error) {
+		data, err := ioutil.ReadAll(r)
+		stored = data
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), err
+	}
+	opts.State = checkpoint
+
+	state, err := c.ArchiveAndDeleteChannel("messaging", "general", opts)
+	mustNoError(t, err, "resume archive")
+
+	sum := sha256.Sum256([]byte(export))
+	assert.Equal(t, export, string(stored))
+	assert.Equal(t, hex.EncodeToString(sum[:]), state.SHA256)
+	assert.True(t, state.Deleted)
+	assert.Equal(t, 1, calls["POST /export_channels"], "export is not repeated on resume")
+	assert.Equal(t, 1, calls["DELETE /channels/messaging/general"])
+}
+
+func TestClient_ArchiveAndDeleteChannel_HashMismatch(t *testing.T) {
+	var deleted bool
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = true
+		}
+		_, _ = w.Write([]byte("partial export"))
+	})
+
+	_, err := c.ArchiveAndDeleteChannel("messaging", "general", &ArchiveOptions{
+		State: &ArchiveState{Type: "messaging", ID: "general", TaskID: "task-1", URL: c.BaseURL + "/export.json"},
+		Store: func(state *ArchiveState, r io.Reader) (string, error) {
+			return "0000", nil
+		},
+	})
+	mustError(t, err, "hash mismatch")
+	assert.False(t, deleted, "channel is kept when stored export isn't verified")
+}
+
+func TestClient_ArchiveAndDeleteChannel_AlreadyDeleted(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":16,"message":"channel not found"}`))
+	})
+
+	// deleted, but the process stopped before the deletion was checkpointed
+	state, err := c.ArchiveAndDeleteChannel("messaging", "general", &ArchiveOptions{
+		State: &ArchiveState{Type: "messaging", ID: "general", TaskID: "task-1", URL: c.BaseURL + "/export.json", SHA256: "abc"},
+		Store: func(state *ArchiveState, r io.Reader) (string, error) {
+			return "", errors.New("export is already stored")
+		},
+	})
+	mustNoError(t, err, "resume archive")
+	assert.True(t, state.Deleted, "missing channel counts as deleted")
+}
+
+func TestClient_ArchiveAndDeleteChannel_SlowDownload(t *testing.T) {
+	const export = `{"channel":{"cid":"messaging:general"},"messages":[]}`
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/export.json" {
+			// slower than the API timeout
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte(export))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	})
+	c.HTTP.Timeout = 20 * time.Millisecond
+
+	state, err := c.ArchiveAndDeleteChannel("messaging", "general", &ArchiveOptions{
+		State: &ArchiveState{Type: "messaging", ID: "general", TaskID: "task-1", URL: c.BaseURL + "/export.json"},
+		Store: func(state *ArchiveState, r io.Reader) (string, error) {
+			data, err := ioutil.ReadAll(r)
+			sum := sha256.Sum256(data)
+			return hex.EncodeToString(sum[:]), err
+		},
+	})
+	mustNoError(t, err, "archive with slow download")
+	assert.NotEmpty(t, state.SHA256, "export is stored")
+}
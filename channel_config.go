@@ -17,6 +17,24 @@ type ChannelConfig struct {
 
 	Automod     modType      `json:"automod"` // disabled, simple or AI
 	ModBehavior modBehaviour `json:"automod_behavior"`
+
+	AutomodThresholds *Thresholds `json:"automod_thresholds,omitempty"` // score limits of AI automod
+
+	Blocklist         string            `json:"blocklist,omitempty"`          // name of the blocklist applied to messages
+	BlocklistBehavior blocklistBehavior `json:"blocklist_behavior,omitempty"` // flag or block messages with blocked words
+}
+
+// LabelThresholds are score limits, from 0 to 1, above which a message is flagged or blocked
+type LabelThresholds struct {
+	Flag  float32 `json:"flag"`
+	Block float32 `json:"block"`
+}
+
+// Thresholds are AI automod score limits per label
+type Thresholds struct {
+	Explicit *LabelThresholds `json:"explicit,omitempty"`
+	Spam     *LabelThresholds `json:"spam,omitempty"`
+	Toxic    *LabelThresholds `json:"toxic,omitempty"`
 }
 
 var DefaultChannelConfig = ChannelConfig{
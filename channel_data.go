@@ -0,0 +1,64 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// RegisterChannelDataType registers a struct type for custom data of channels of given type.
+// Custom fields of such channels returned by the API, ie by CreateChannel, QueryChannels or AddMembers,
+// are decoded into a new value of the prototype's type, available as channel's Data.
+// The custom fields are decoded a second time for this, they are still available in ExtraData too.
+//
+// prototype: struct value or pointer to it, ie RegisterChannelDataType("livestream", &StreamInfo{})
+func (c *Client) RegisterChannelDataType(chanType string, prototype interface{}) error {
+	if chanType == "" {
+		return errors.New("channel type is empty")
+	}
+
+	t := reflect.TypeOf(prototype)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return errors.New("channel data prototype must be a struct or pointer to struct")
+	}
+
+	c.dataTypesMu.Lock()
+	defer c.dataTypesMu.Unlock()
+
+	c.dataTypes[chanType] = t
+
+	return nil
+}
+
+func (c *Client) channelDataType(chanType string) (reflect.Type, bool) {
+	c.dataTypesMu.RLock()
+	defer c.dataTypesMu.RUnlock()
+
+	t, ok := c.dataTypes[chanType]
+	return t, ok
+}
+
+// decodeData fills channel's Data from raw, the channel object of a response,
+// if a data type is registered for channel's type
+func (ch *Channel) decodeData(raw []byte) error {
+	if ch.client == nil {
+		return nil
+	}
+
+	t, ok := ch.client.channelDataType(ch.Type)
+	if !ok {
+		return nil
+	}
+
+	data := reflect.New(t).Interface()
+	if err := json.Unmarshal(raw, data); err != nil {
+		return err
+	}
+
+	ch.Data = data
+
+	return nil
+}
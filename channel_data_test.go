@@ -0,0 +1,72 @@
+package stream_chat
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannel_decodeData(t *testing.T) {
+	type testChannelData struct {
+		Topic    string   `json:"topic"`
+		Tags     []string `json:"tags"`
+		Priority int64    `json:"priority"`
+	}
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	mustNoError(t, c.RegisterChannelDataType("livestream", &testChannelData{}), "register data type")
+	mustError(t, c.RegisterChannelDataType("livestream", "not a struct"), "register non struct")
+
+	// the priority doesn't survive a round trip through float64
+	raw := []byte(`{"type":"livestream","topic":"second breakfast","tags":["food","hobbits"],"priority":9007199254740993}`)
+
+	ch := c.newChannel("livestream", "")
+	mustNoError(t, queryResponse{Channel: raw}.updateChannel(ch), "update channel")
+
+	assert.Equal(t, &testChannelData{Topic: "second breakfast", Tags: []string{"food", "hobbits"}, Priority: 9007199254740993}, ch.Data)
+
+	other := c.newChannel("messaging", "")
+	mustNoError(t, queryResponse{Channel: []byte(`{"type":"messaging","topic":"elevenses"}`)}.updateChannel(other), "update channel")
+
+	assert.Nil(t, other.Data, "data type is not registered")
+}
+
+func TestChannel_AddMembers_keepsData(t *testing.T) {
+	type testChannelData struct {
+		Topic string `json:"topic"`
+	}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"channel":{"type":"livestream","id":"shire","topic":"second breakfast"},"members":[{"user_id":"frodo"}]}`))
+	})
+
+	mustNoError(t, c.RegisterChannelDataType("livestream", testChannelData{}), "register data type")
+
+	ch := c.newChannel("livestream", "shire")
+	mustNoError(t, ch.AddMembers([]string{"frodo"}, nil), "add members")
+
+	assert.Equal(t, &testChannelData{Topic: "second breakfast"}, ch.Data)
+}
+
+func TestClient_RegisterChannelDataType(t *testing.T) {
+	type testChannelData struct {
+		Topic string `json:"topic"`
+	}
+
+	c := initClient(t)
+
+	mustNoError(t, c.RegisterChannelDataType("messaging", testChannelData{}), "register data type")
+
+	ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, map[string]interface{}{
+		"topic": "the road goes ever on",
+	})
+	mustNoError(t, err, "create channel")
+	defer ch.Delete(false)
+
+	if assert.IsType(t, &testChannelData{}, ch.Data) {
+		assert.Equal(t, "the road goes ever on", ch.Data.(*testChannelData).Topic)
+	}
+}
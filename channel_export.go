@@ -0,0 +1,75 @@
+package stream_chat
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ExportChannelRequest selects a channel and the messages to export
+type ExportChannelRequest struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+
+	MessagesSince *time.Time `json:"messages_since,omitempty"` // export messages created since, all if nil
+	MessagesUntil *time.Time `json:"messages_until,omitempty"` // export messages created until, all if nil
+}
+
+// ExportChannelsOptions controls the content of the channel export
+type ExportChannelsOptions struct {
+	ClearDeletedMessageText  bool `json:"clear_deleted_message_text,omitempty"` // export deleted messages without their text
+	IncludeTruncatedMessages bool `json:"include_truncated_messages,omitempty"` // export messages removed by Channel.Truncate
+}
+
+type exportChannelsRequest struct {
+	Channels []*ExportChannelRequest `json:"channels"`
+
+	ClearDeletedMessageText  bool `json:"clear_deleted_message_text,omitempty"`
+	IncludeTruncatedMessages bool `json:"include_truncated_messages,omitempty"`
+}
+
+// ExportChannels starts an export of the channels and returns the ID of the export task,
+// when the task is completed its result contains the URL of the export file,
+// see GetExportChannelsStatus and WaitForTask. options may be nil.
+func (c *Client) ExportChannels(channels []*ExportChannelRequest, options *ExportChannelsOptions) (string, error) {
+	if len(channels) == 0 {
+		return "", errors.New("channels are empty")
+	}
+
+	for _, ch := range channels {
+		if ch == nil || ch.Type == "" || ch.ID == "" {
+			return "", errors.New("channel type or ID is empty")
+		}
+	}
+
+	req := exportChannelsRequest{Channels: channels}
+	if options != nil {
+		req.ClearDeletedMessageText = options.ClearDeletedMessageText
+		req.IncludeTruncatedMessages = options.IncludeTruncatedMessages
+	}
+
+	var resp taskResponse
+
+	err := c.makeRequest(http.MethodPost, "export_channels", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+// GetExportChannelsStatus returns current state of the channel export task started by ExportChannels,
+// the result of a completed task contains the URL of the export file, ie {"url": exportURL}
+func (c *Client) GetExportChannelsStatus(taskID string) (*Task, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID is empty")
+	}
+
+	p := buildPath("export_channels", taskID)
+
+	var task Task
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
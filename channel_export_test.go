@@ -0,0 +1,49 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ExportChannels(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /export_channels":
+			data, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &body)
+			_, _ = w.Write([]byte(`{"task_id":"task-1"}`))
+		case "GET /export_channels/task-1":
+			_, _ = w.Write([]byte(`{"task_id":"task-1","status":"completed","result":{"url":"https://example.com/export.json"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	taskID, err := c.ExportChannels([]*ExportChannelRequest{
+		{Type: "messaging", ID: "general", MessagesSince: &since},
+	}, &ExportChannelsOptions{IncludeTruncatedMessages: true})
+	mustNoError(t, err, "export channels")
+	assert.Equal(t, "task-1", taskID)
+	assert.Equal(t, true, body["include_truncated_messages"])
+	assert.NotContains(t, body, "clear_deleted_message_text")
+
+	task, err := c.GetExportChannelsStatus(taskID)
+	mustNoError(t, err, "get export status")
+	assert.True(t, task.Done())
+	assert.Equal(t, "https://example.com/export.json", task.Result["url"])
+
+	_, err = c.ExportChannels(nil, nil)
+	mustError(t, err, "no channels")
+
+	_, err = c.GetExportChannelsStatus("")
+	mustError(t, err, "empty task ID")
+}
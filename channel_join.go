@@ -0,0 +1,74 @@
+package stream_chat
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pascaldekloe/jwt"
+)
+
+const joinTokenClaim = "join_cid"
+
+// CreateJoinToken creates a signed invite link token which allows any user to join the channel until expire time
+func (ch *Channel) CreateJoinToken(expire time.Time) ([]byte, error) {
+	switch {
+	case ch.Type == "" || ch.ID == "":
+		return nil, errors.New("channel type or ID is empty")
+	case expire.IsZero():
+		return nil, errors.New("join token expire time is not set")
+	case !expire.After(time.Now()):
+		return nil, errors.New("join token expire time is in the past")
+	}
+
+	params := map[string]interface{}{
+		joinTokenClaim: ch.Type + ":" + ch.ID,
+	}
+
+	return ch.client.createToken(params, expire)
+}
+
+// ValidateJoinToken checks signature and expiration of the join token, returns type and ID of the channel it grants access to
+func (c *Client) ValidateJoinToken(token []byte) (chanType string, chanID string, err error) {
+	claims, err := jwt.HMACCheck(token, c.apiSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	if claims.Expires == nil || !claims.Valid(time.Now()) {
+		return "", "", errors.New("join token is expired")
+	}
+
+	cid, ok := claims.String(joinTokenClaim)
+	if !ok {
+		return "", "", errors.New("token is not a join token")
+	}
+
+	parts := strings.SplitN(cid, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("join token has malformed channel ID")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// RedeemJoinToken validates the join token and adds user with given ID as a member of the channel,
+// returns the channel with refreshed state
+func (c *Client) RedeemJoinToken(token []byte, userID string) (*Channel, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	chanType, chanID, err := c.ValidateJoinToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := c.newChannel(chanType, chanID)
+
+	if err := ch.AddMembers([]string{userID}, nil); err != nil {
+		return nil, err
+	}
+
+	return ch, ch.refresh()
+}
@@ -0,0 +1,89 @@
+package stream_chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pascaldekloe/jwt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ValidateJoinToken(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "fellowship-of-the-ring", client: c}
+
+	t.Run("valid token", func(t *testing.T) {
+		token, err := ch.CreateJoinToken(time.Now().Add(time.Hour))
+		mustNoError(t, err, "create join token")
+
+		chanType, chanID, err := c.ValidateJoinToken(token)
+		mustNoError(t, err, "validate join token")
+
+		assert.Equal(t, ch.Type, chanType)
+		assert.Equal(t, ch.ID, chanID)
+	})
+
+	t.Run("expire in the past", func(t *testing.T) {
+		_, err := ch.CreateJoinToken(time.Now().Add(-time.Hour))
+		mustError(t, err, "create join token")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := jwt.Claims{Set: map[string]interface{}{joinTokenClaim: "messaging:fellowship-of-the-ring"}}
+		claims.Expires = jwt.NewNumericTime(time.Now().Add(-time.Minute))
+
+		token, err := claims.HMACSign(jwt.HS256, c.apiSecret)
+		mustNoError(t, err, "sign token")
+
+		_, _, err = c.ValidateJoinToken(token)
+		mustError(t, err, "validate join token")
+	})
+
+	t.Run("user token", func(t *testing.T) {
+		token, err := c.CreateToken("frodo-baggins", time.Now().Add(time.Hour))
+		mustNoError(t, err, "create token")
+
+		_, _, err = c.ValidateJoinToken(token)
+		mustError(t, err, "validate join token")
+	})
+
+	t.Run("foreign secret", func(t *testing.T) {
+		other, err := NewClient("key", []byte("other secret"))
+		mustNoError(t, err, "new client")
+
+		token, err := (&Channel{Type: ch.Type, ID: ch.ID, client: other}).CreateJoinToken(time.Now().Add(time.Hour))
+		mustNoError(t, err, "create join token")
+
+		_, _, err = c.ValidateJoinToken(token)
+		mustError(t, err, "validate join token")
+	})
+}
+
+func TestClient_RedeemJoinToken(t *testing.T) {
+	c := initClient(t)
+
+	ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, nil)
+	mustNoError(t, err, "create channel")
+	defer ch.Delete(false)
+
+	token, err := ch.CreateJoinToken(time.Now().Add(time.Hour))
+	mustNoError(t, err, "create join token")
+
+	user := randomUser()
+
+	got, err := c.RedeemJoinToken(token, user.ID)
+	mustNoError(t, err, "redeem join token")
+
+	assert.Equal(t, ch.CID, got.CID)
+	assert.Condition(t, func() bool {
+		for _, m := range got.Members {
+			if m.User.ID == user.ID {
+				return true
+			}
+		}
+		return false
+	}, "user is a member")
+}
@@ -0,0 +1,199 @@
+package stream_chat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const dndPageSize = 30
+
+// ChannelMute is a mute of a channel by a user, see MuteChannel
+type ChannelMute struct {
+	User      *User      `json:"user"`
+	Channel   *Channel   `json:"channel"`
+	Expires   *time.Time `json:"expires,omitempty"` // nil for mutes without expiration
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// QueryChannelMutes returns the channels muted by the user with given ID
+func (c *Client) QueryChannelMutes(userID string) ([]*ChannelMute, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	users, err := c.QueryUsers(&QueryOption{
+		Filter: map[string]interface{}{"id": map[string]interface{}{"$eq": userID}},
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+
+	return users[0].ChannelMutes, nil
+}
+
+// MuteChannel mutes the channel with given cid for userID, so the user gets no push notifications from it.
+// The mute expires after expiration unless it's zero.
+func (c *Client) MuteChannel(cid string, userID string, expiration time.Duration) error {
+	switch {
+	case cid == "":
+		return errors.New("channel cid is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	case expiration < 0:
+		return errors.New("expiration is negative")
+	}
+
+	data := map[string]interface{}{
+		"channel_cid": cid,
+		"user_id":     userID,
+	}
+
+	if expiration > 0 {
+		data["expiration"] = int64(expiration / time.Millisecond)
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/mute/channel", nil, data, nil)
+}
+
+// UnmuteChannel removes the mute of the channel with given cid for userID
+func (c *Client) UnmuteChannel(cid string, userID string) error {
+	switch {
+	case cid == "":
+		return errors.New("channel cid is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"channel_cid": cid,
+		"user_id":     userID,
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/unmute/channel", nil, data, nil)
+}
+
+// MuteChannels mutes every channel matching filter that userID is a member of,
+// ie {"type": "livestream"}; nil filter mutes all of the user's channels.
+// The mutes expire after expiration unless it's zero. Returns number of muted channels.
+// Mutes are throttled by client's BulkThrottle.
+func (c *Client) MuteChannels(filter map[string]interface{}, userID string, expiration time.Duration) (int, error) {
+	switch {
+	case userID == "":
+		return 0, errors.New("user ID is empty")
+	case expiration < 0:
+		return 0, errors.New("expiration is negative")
+	}
+
+	cids, err := c.memberChannelCIDs(filter, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var muted int32
+
+	err = c.BulkThrottle.run(len(cids), func(i int) error {
+		if err := c.MuteChannel(cids[i], userID, expiration); err != nil {
+			return err
+		}
+
+		atomic.AddInt32(&muted, 1)
+		return nil
+	})
+
+	return int(muted), err
+}
+
+// memberChannelCIDs returns cids of all channels matching filter that userID is a member of
+func (c *Client) memberChannelCIDs(filter map[string]interface{}, userID string) ([]string, error) {
+	f := map[string]interface{}{}
+	for k, v := range filter {
+		f[k] = v
+	}
+	f["members"] = map[string]interface{}{"$in": []string{userID}}
+
+	var cids []string
+
+	for offset := 0; ; offset += dndPageSize {
+		q := &QueryOption{Filter: f, Limit: dndPageSize, Offset: offset}
+
+		channels, err := c.QueryChannels(q, &SortOption{Field: "created_at", Direction: 1})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ch := range channels {
+			cids = append(cids, ch.CID)
+		}
+
+		if len(channels) < dndPageSize {
+			return cids, nil
+		}
+	}
+}
+
+// MuteChannelsUntil mutes every channel userID is a member of until the given time,
+// ie for a "do not disturb" window. Mutes expire by themselves, so there is nothing to undo.
+// Returns number of muted channels.
+// Channels already muted without expiration or until a later time are left as they are,
+// so a permanent mute is not shortened to the window.
+// Channels joined after the call are not muted. Mutes are throttled by client's BulkThrottle.
+func (c *Client) MuteChannelsUntil(userID string, until time.Time) (int, error) {
+	switch {
+	case userID == "":
+		return 0, errors.New("user ID is empty")
+	case !until.After(time.Now()):
+		return 0, errors.New("until must be in the future")
+	}
+
+	mutes, err := c.QueryChannelMutes(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	outlasting := make(map[string]bool, len(mutes))
+	for _, m := range mutes {
+		if m.Channel != nil && (m.Expires == nil || !m.Expires.Before(until)) {
+			outlasting[m.Channel.CID] = true
+		}
+	}
+
+	memberCIDs, err := c.memberChannelCIDs(nil, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	cids := memberCIDs[:0]
+	for _, cid := range memberCIDs {
+		if !outlasting[cid] {
+			cids = append(cids, cid)
+		}
+	}
+
+	var muted int32
+
+	err = c.BulkThrottle.run(len(cids), func(i int) error {
+		// computed per channel so late mutes don't outlast the window
+		expiration := time.Until(until)
+		if expiration <= 0 {
+			return nil
+		}
+
+		if err := c.MuteChannel(cids[i], userID, expiration); err != nil {
+			return err
+		}
+
+		atomic.AddInt32(&muted, 1)
+		return nil
+	})
+
+	return int(muted), err
+}
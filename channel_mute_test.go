@@ -0,0 +1,68 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_MuteChannel(t *testing.T) {
+	var (
+		paths  []string
+		bodies []map[string]interface{}
+	)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		data, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	mustError(t, c.MuteChannel("", "frodo", 0), "empty cid")
+	mustError(t, c.MuteChannel("messaging:shire", "", 0), "empty user ID")
+	mustError(t, c.MuteChannel("messaging:shire", "frodo", -time.Second), "negative expiration")
+	assert.Empty(t, paths, "invalid mutes are not sent")
+
+	mustNoError(t, c.MuteChannel("messaging:shire", "frodo", time.Minute), "mute channel")
+	mustNoError(t, c.UnmuteChannel("messaging:shire", "frodo"), "unmute channel")
+
+	if assert.Len(t, paths, 2) {
+		assert.Equal(t, "/moderation/mute/channel", paths[0])
+		assert.Equal(t, "messaging:shire", bodies[0]["channel_cid"])
+		assert.Equal(t, "frodo", bodies[0]["user_id"])
+		assert.EqualValues(t, 60000, bodies[0]["expiration"], "expiration in milliseconds")
+
+		assert.Equal(t, "/moderation/unmute/channel", paths[1])
+		assert.Equal(t, "messaging:shire", bodies[1]["channel_cid"])
+	}
+}
+
+func TestClient_QueryChannelMutes(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users", r.URL.Path)
+		_, _ = w.Write([]byte(`{"users": [{"id": "frodo", "channel_mutes": [
+			{"user": {"id": "frodo"}, "channel": {"cid": "messaging:shire"}, "expires": "2030-01-01T00:00:00Z"},
+			{"user": {"id": "frodo"}, "channel": {"cid": "messaging:mordor"}}
+		]}]}`))
+	})
+
+	mutes, err := c.QueryChannelMutes("frodo")
+	mustNoError(t, err, "query channel mutes")
+
+	if assert.Len(t, mutes, 2) {
+		assert.Equal(t, "messaging:shire", mutes[0].Channel.CID)
+		if assert.NotNil(t, mutes[0].Expires) {
+			assert.Equal(t, 2030, mutes[0].Expires.Year())
+		}
+		assert.Equal(t, "messaging:mordor", mutes[1].Channel.CID)
+		assert.Nil(t, mutes[1].Expires, "mute without expiration")
+	}
+}
@@ -0,0 +1,62 @@
+package stream_chat
+
+import (
+	"errors"
+	"net/http"
+)
+
+const rolloutPageSize = 30
+
+func (ch *Channel) updateConfigOverrides(overrides map[string]interface{}) error {
+	data := map[string]interface{}{
+		"config_overrides": overrides,
+	}
+
+	return ch.client.makeRequest(http.MethodPost, ch.path(), nil, data, nil)
+}
+
+// RolloutConfigOverrides applies config overrides, ie {"uploads": false}, to all channels matching the filter.
+// Returns number of updated channels.
+//
+// Channels are walked in creation order starting from offset. After every page checkpoint is called
+// with the offset to resume from, so an interrupted rollout can be continued by passing the last saved offset.
+// Updates of every page are throttled by client's BulkThrottle.
+func (c *Client) RolloutConfigOverrides(filter map[string]interface{}, overrides map[string]interface{}, offset int, checkpoint func(offset int) error) (int, error) {
+	switch {
+	case len(overrides) == 0:
+		return 0, errors.New("config overrides are empty")
+	case offset < 0:
+		return 0, errors.New("offset must be non-negative")
+	}
+
+	var updated int
+
+	for {
+		q := &QueryOption{Filter: filter, Limit: rolloutPageSize, Offset: offset}
+
+		channels, err := c.QueryChannels(q, &SortOption{Field: "created_at", Direction: 1})
+		if err != nil {
+			return updated, err
+		}
+
+		err = c.BulkThrottle.run(len(channels), func(i int) error {
+			return channels[i].updateConfigOverrides(overrides)
+		})
+		if err != nil {
+			return updated, err
+		}
+
+		updated += len(channels)
+		offset += len(channels)
+
+		if checkpoint != nil {
+			if err := checkpoint(offset); err != nil {
+				return updated, err
+			}
+		}
+
+		if len(channels) < rolloutPageSize {
+			return updated, nil
+		}
+	}
+}
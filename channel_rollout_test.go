@@ -0,0 +1,35 @@
+package stream_chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_RolloutConfigOverrides(t *testing.T) {
+	c := initClient(t)
+
+	team := randomString(8)
+
+	for i := 0; i < 3; i++ {
+		ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, map[string]interface{}{"team": team})
+		mustNoError(t, err, "create channel")
+		defer ch.Delete(false)
+	}
+
+	var checkpoints []int
+
+	updated, err := c.RolloutConfigOverrides(
+		map[string]interface{}{"team": team},
+		map[string]interface{}{"uploads": false},
+		1,
+		func(offset int) error {
+			checkpoints = append(checkpoints, offset)
+			return nil
+		},
+	)
+	mustNoError(t, err, "rollout config overrides")
+
+	assert.Equal(t, 2, updated, "channels after offset are updated")
+	assert.Equal(t, []int{3}, checkpoints)
+}
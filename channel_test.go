@@ -2,7 +2,9 @@ package stream_chat
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -55,13 +57,13 @@ func TestChannel_AddMembers(t *testing.T) {
 
 	ch, err := c.CreateChannel("messaging", chanID, serverUser.ID, nil)
 	mustNoError(t, err, "create channel")
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	assert.Empty(t, ch.Members, "members are empty")
 
 	user := randomUser()
 
-	err = ch.AddMembers(user.ID)
+	err = ch.AddMembers([]string{user.ID}, nil)
 	mustNoError(t, err, "add members")
 
 	// refresh channel state
@@ -70,6 +72,32 @@ func TestChannel_AddMembers(t *testing.T) {
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "members contain user id")
 }
 
+func TestChannel_AddMembersOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	_, err := ch.SendMessage(&Message{Text: "before the member joined"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	user := randomUser()
+
+	err = ch.AddMembers([]string{user.ID}, &AddMemberOptions{
+		HideHistory: true,
+		Message:     &Message{Text: user.ID + " joined", User: serverUser},
+		MemberRole:  "channel_moderator",
+	})
+	mustNoError(t, err, "add members")
+
+	for _, m := range ch.Members {
+		if m.User.ID == user.ID {
+			assert.Equal(t, "channel_moderator", m.ChannelRole, "member role is set")
+			return
+		}
+	}
+	t.Error("members contain user id")
+}
+
 func TestChannel_Moderation(t *testing.T) {
 	c := initClient(t)
 
@@ -77,7 +105,7 @@ func TestChannel_Moderation(t *testing.T) {
 	chanID := randomString(12)
 	ch, err := c.CreateChannel("messaging", chanID, serverUser.ID, nil)
 	mustNoError(t, err, "create channel")
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	assert.Empty(t, ch.Members, "members are empty")
 
@@ -105,7 +133,7 @@ func TestChannel_Moderation(t *testing.T) {
 func TestChannel_BanUser(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 
@@ -126,14 +154,20 @@ func TestChannel_Delete(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
 
-	err := ch.Delete()
+	err := ch.Delete(false)
 	mustNoError(t, err, "delete channel")
+
+	ch, err = c.CreateChannel("messaging", randomString(12), serverUser.ID, nil)
+	mustNoError(t, err, "create channel")
+
+	err = ch.Delete(true)
+	mustNoError(t, err, "hard delete channel")
 }
 
 func TestChannel_GetReplies(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 
@@ -152,32 +186,159 @@ func TestChannel_GetReplies(t *testing.T) {
 }
 
 func TestChannel_MarkRead(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
 
+	msg, err := ch.SendMessage(&Message{Text: "unread message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, ch.MarkRead(user.ID, msg.ID), "mark read up to message")
+	mustNoError(t, ch.MarkRead(user.ID, ""), "mark read")
+	mustNoError(t, c.MarkAllRead(user.ID), "mark all read")
+
+	mustError(t, ch.MarkRead("", msg.ID), "empty user ID")
+}
+
+func TestChannel_MarkUnread(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	msg, err := ch.SendMessage(&Message{Text: "read message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, ch.MarkRead(user.ID, ""), "mark read")
+	mustNoError(t, ch.MarkUnread(user.ID, msg.ID), "mark unread")
+
+	mustError(t, ch.MarkUnread(user.ID, ""), "empty message ID")
+}
+
+func TestChannel_PartialUpdate(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	err := ch.Update(map[string]interface{}{"topic": "breakfast", "color": "blue"}, nil)
+	mustNoError(t, err, "update channel")
+
+	err = ch.PartialUpdate(map[string]interface{}{"topic": "lunch"}, []string{"color"})
+	mustNoError(t, err, "partial update channel")
+
+	mustNoError(t, ch.refresh(), "refresh channel")
+
+	assert.Equal(t, "lunch", ch.ExtraData["topic"])
+	assert.NotContains(t, ch.ExtraData, "color")
+
+	mustError(t, ch.PartialUpdate(nil, nil), "nothing to update")
+}
+
+func TestChannel_Refresh(t *testing.T) {
+	c := initClient(t)
+
+	ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, nil)
+	mustNoError(t, err, "create channel")
+	defer ch.Delete(false)
+
+	before := ch.Snapshot()
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = len(ch.Snapshot().Members)
+		}
+	}()
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	<-done
+
+	assert.Empty(t, before.Members, "snapshot is not changed by refresh")
+	assert.Equal(t, user.ID, ch.Snapshot().Members[0].User.ID, "members contain user id")
+}
+
+func TestChannel_AddMembers_concurrentSnapshot(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"channel":{"type":"messaging","id":"shire","member_count":1},"members":[{"user_id":"frodo"}]}`))
+	})
+
+	ch := c.newChannel("messaging", "shire")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = len(ch.Snapshot().Members)
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		mustNoError(t, ch.AddMembers([]string{"frodo"}, nil), "add members")
+	}
+	<-done
+
+	assert.Equal(t, 1, ch.Snapshot().MemberCount)
 }
 
 func TestChannel_RemoveMembers(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
-	err := ch.RemoveMembers(user.ID)
+	err := ch.RemoveMembers([]string{user.ID}, nil)
 
 	mustNoError(t, err, "remove members")
 
 	for _, member := range ch.Members {
 		assert.NotEqual(t, member.User.ID, user.ID, "member is not present")
 	}
+
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	err = ch.RemoveMembers([]string{user.ID}, &Message{Text: user.ID + " was removed", User: serverUser})
+	mustNoError(t, err, "remove members with message")
+
+	for _, member := range ch.Members {
+		assert.NotEqual(t, member.User.ID, user.ID, "member is not present")
+	}
+
+	mustError(t, ch.RemoveMembers([]string{user.ID}, &Message{Text: "no user"}), "message without user")
 }
 
 func TestChannel_SendEvent(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
 
+	user := randomUser()
+
+	err := ch.SendEvent(&Event{Type: EventTypingStart}, user.ID)
+	mustNoError(t, err, "send typing event")
+
+	err = ch.SendEvent(&Event{
+		Type:      "game.move",
+		ExtraData: map[string]interface{}{"square": "e4"},
+	}, user.ID)
+	mustNoError(t, err, "send custom event")
+
+	err = ch.SendEvent(&Event{Type: EventTypingStop}, "")
+	mustError(t, err, "send event without user")
 }
 
 func TestChannel_SendMessage(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 	msg := &Message{
@@ -195,7 +356,7 @@ func TestChannel_SendMessage(t *testing.T) {
 func TestChannel_Truncate(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 	msg := &Message{
@@ -210,7 +371,7 @@ func TestChannel_Truncate(t *testing.T) {
 
 	assert.Equal(t, ch.Messages[0].ID, msg.ID, "message exists")
 
-	err = ch.Truncate()
+	err = ch.Truncate(nil)
 	mustNoError(t, err, "truncate channel")
 
 	// refresh channel state
@@ -219,6 +380,422 @@ func TestChannel_Truncate(t *testing.T) {
 	assert.Empty(t, ch.Messages, "message not exists")
 }
 
+func TestChannel_TruncateOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message", User: randomUser()}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	err = ch.Truncate(&TruncateOptions{
+		HardDelete:  true,
+		SkipPush:    true,
+		TruncatedAt: time.Now(),
+		Message:     &Message{Text: "history cleared", User: serverUser},
+	})
+	mustNoError(t, err, "truncate channel")
+
+	mustNoError(t, ch.refresh(), "refresh channel")
+
+	for _, m := range ch.Messages {
+		assert.NotEqual(t, msg.ID, m.ID, "message is removed")
+	}
+}
+
+func TestChannel_TruncateMessageUser(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	ch := c.newChannel("messaging", "truncate")
+
+	err = ch.Truncate(&TruncateOptions{Message: &Message{Text: "history cleared"}})
+	assert.Error(t, err)
+}
+
 func TestChannel_Update(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	err := ch.Update(map[string]interface{}{"color": "blue"}, nil)
+	mustNoError(t, err, "update channel")
+
+	err = ch.Update(map[string]interface{}{"color": "red"}, &Message{
+		Text: "color changed to red",
+		User: &User{ID: serverUser.ID},
+	})
+	mustNoError(t, err, "update channel with message")
+
+	mustNoError(t, ch.refresh(), "refresh channel")
+
+	assert.Equal(t, "red", ch.ExtraData["color"])
+	if assert.NotEmpty(t, ch.Messages) {
+		assert.Equal(t, "color changed to red", ch.Messages[len(ch.Messages)-1].Text)
+	}
+
+	err = ch.Update(map[string]interface{}{"color": "green"}, &Message{Text: "no author"})
+	mustError(t, err, "message without user")
+}
+
+func TestClient_SharedChannels(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	got, err := c.SharedChannels(testUsers[0].ID, testUsers[1].ID, ch.Type)
+	mustNoError(t, err, "shared channels")
+
+	assert.Condition(t, func() bool {
+		for _, shared := range got {
+			if shared.CID == ch.CID {
+				return true
+			}
+		}
+		return false
+	}, "shared channel exists")
+}
+
+func TestChannel_QueryPendingMessages(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	msgs, err := ch.QueryPendingMessages(map[string][]string{"limit": {"10"}})
+	mustNoError(t, err, "query pending messages")
+
+	assert.Empty(t, msgs, "no pending messages")
+}
+
+func TestClient_MuteChannelsUntil(t *testing.T) {
+	c := initClient(t)
+
+	// a fresh user, so no other test's channels are counted
+	user := &User{ID: "dnd-" + randomString(10)}
+	_, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert user")
+	defer c.DeleteUser(user.ID, nil)
+
+	var cids []string
+
+	for i := 0; i < 3; i++ {
+		ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, map[string]interface{}{"members": []string{user.ID}})
+		mustNoError(t, err, "create channel")
+		defer ch.Delete(false)
+
+		cids = append(cids, ch.CID)
+	}
+
+	err = c.MuteChannel(cids[0], user.ID, 0)
+	mustNoError(t, err, "mute channel permanently")
+
+	muted, err := c.MuteChannelsUntil(user.ID, time.Now().Add(8*time.Hour))
+	mustNoError(t, err, "mute channels")
+
+	assert.Equal(t, 2, muted, "permanently muted channel is skipped")
+
+	mutes, err := c.QueryChannelMutes(user.ID)
+	mustNoError(t, err, "query channel mutes")
+
+	for _, m := range mutes {
+		if m.Channel.CID == cids[0] {
+			assert.Nil(t, m.Expires, "permanent mute is kept")
+		}
+	}
+
+	_, err = c.MuteChannelsUntil(user.ID, time.Now().Add(-time.Hour))
+	mustError(t, err, "until is in the past")
+}
+
+func TestNewChannelInput(t *testing.T) {
+	in, err := NewChannelInput("messaging", " Fellowship-Of-The-Ring")
+	mustNoError(t, err, "new channel input")
+	assert.Equal(t, "messaging", in.Type)
+	assert.Equal(t, "fellowship-of-the-ring", in.ID, "id is normalized")
+
+	_, err = NewChannelInput("", "general")
+	mustError(t, err, "empty type")
+
+	_, err = NewChannelInput("messaging", "fellowship:ring")
+	mustError(t, err, "invalid characters")
+}
+
+func TestClient_EnsureChannels(t *testing.T) {
+	c := initClient(t)
+
+	data := map[string]interface{}{"course": "hobbit-studies"}
+
+	var inputs []*ChannelInput
+	for i := 0; i < 3; i++ {
+		in, err := NewChannelInput("messaging", randomString(12))
+		mustNoError(t, err, "new channel input")
+
+		in.CreatedByID = serverUser.ID
+		in.Data = data
+		inputs = append(inputs, in)
+	}
+	inputs = append(inputs, &ChannelInput{Type: "messaging", ID: randomString(12)})
+
+	results := c.EnsureChannels(inputs)
+
+	if !assert.Len(t, results, len(inputs)) {
+		return
+	}
+
+	for i, r := range results[:3] {
+		mustNoError(t, r.Err, "ensure channel")
+		defer r.Channel.Delete(false)
+
+		assert.Equal(t, inputs[i], r.Input, "results are in the order of inputs")
+		assert.Equal(t, inputs[i].ID, r.Channel.ID)
+		assert.Equal(t, "hobbit-studies", r.Channel.ExtraData["course"])
+	}
+
+	mustError(t, results[3].Err, "creator is missing")
+	assert.Nil(t, results[3].Channel)
+	assert.Len(t, data, 1, "input data is not modified")
+}
+
+func TestClient_DeleteChannels(t *testing.T) {
+	c := initClient(t)
+
+	var cids []string
+	for i := 0; i < 2; i++ {
+		ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, nil)
+		mustNoError(t, err, "create channel")
+		cids = append(cids, ch.CID)
+	}
+
+	taskID, err := c.DeleteChannels(cids, true)
+	mustNoError(t, err, "delete channels")
+
+	task, err := c.WaitForTask(taskID, time.Second, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+
+	_, err = c.DeleteChannels(make([]string, maxChannelsPerDelete+1), false)
+	mustError(t, err, "too many cids")
+}
+
+func TestChannel_HideShow(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	q := &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID, "members": map[string]interface{}{"$in": []string{user.ID}}},
+		UserID: user.ID,
+	}
+
+	mustNoError(t, ch.Hide(user.ID, true), "hide channel")
+
+	channels, err := c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Empty(t, channels, "channel is hidden")
+
+	mustNoError(t, ch.Show(user.ID), "show channel")
+
+	channels, err = c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Len(t, channels, 1, "channel is shown")
+}
+
+func TestChannel_AssignRoles(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	err := ch.AssignRoles([]*RoleAssignment{{UserID: user.ID, ChannelRole: "channel_moderator"}})
+	mustNoError(t, err, "assign roles")
+
+	for _, m := range ch.Members {
+		if m.User.ID == user.ID {
+			assert.Equal(t, "channel_moderator", m.ChannelRole)
+		}
+	}
+
+	mustError(t, ch.AssignRoles(nil), "no assignments")
+	mustError(t, ch.AssignRoles([]*RoleAssignment{{UserID: user.ID}}), "empty role")
+}
+
+func TestChannel_Invites(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	users := randomUsers(2)
+	frodo, sam := users[0], users[1]
+
+	mustNoError(t, ch.InviteMembers(frodo.ID, sam.ID), "invite members")
+	mustNoError(t, ch.AcceptInvite(frodo.ID, &Message{Text: "frodo joined", User: frodo}), "accept invite")
+	mustNoError(t, ch.RejectInvite(sam.ID, nil), "reject invite")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+
+	for _, m := range ch.Snapshot().Members {
+		switch m.User.ID {
+		case frodo.ID:
+			assert.NotNil(t, m.InviteAcceptedAt, "invite is accepted")
+		case sam.ID:
+			assert.NotNil(t, m.InviteRejectedAt, "invite is rejected")
+		}
+	}
+
+	mustError(t, ch.InviteMembers(), "no user IDs")
+	mustError(t, ch.AcceptInvite("", nil), "empty user ID")
+}
+
+func TestChannel_SlowMode(t *testing.T) {
+	c := initClient(t)
+
+	ch, err := c.CreateChannel("livestream", randomString(12), serverUser.ID, map[string]interface{}{"cooldown": 5})
+	mustNoError(t, err, "create channel")
+	defer ch.Delete(false)
+
+	assert.Equal(t, 5, ch.Cooldown, "cooldown is set on create")
+
+	mustNoError(t, ch.EnableSlowMode(30), "enable slow mode")
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.Equal(t, 30, ch.Snapshot().Cooldown)
+
+	mustNoError(t, ch.DisableSlowMode(), "disable slow mode")
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.Zero(t, ch.Snapshot().Cooldown)
+
+	mustError(t, ch.EnableSlowMode(0), "cooldown too short")
+	mustError(t, ch.EnableSlowMode(121), "cooldown too long")
+}
+
+func TestChannel_PartialUpdateMember(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	member, err := ch.PartialUpdateMember(user.ID, map[string]interface{}{"notification_level": "mentions", "nickname": "ringbearer"}, nil)
+	mustNoError(t, err, "partial update member")
+	assert.Equal(t, "mentions", member.ExtraData["notification_level"])
+
+	member, err = ch.PartialUpdateMember(user.ID, nil, []string{"nickname"})
+	mustNoError(t, err, "unset member property")
+	assert.Equal(t, "mentions", member.ExtraData["notification_level"], "other properties are kept")
+	assert.NotContains(t, member.ExtraData, "nickname")
+
+	_, err = ch.PartialUpdateMember(user.ID, nil, nil)
+	mustError(t, err, "nothing to update")
+}
+
+func TestChannel_Freeze(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	mustNoError(t, ch.Freeze(&Message{Text: "this channel is locked", User: serverUser}), "freeze channel")
+	assert.True(t, ch.Frozen)
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.True(t, ch.Snapshot().Frozen, "channel is frozen")
+
+	mustNoError(t, ch.Unfreeze(nil), "unfreeze channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.False(t, ch.Snapshot().Frozen, "channel is not frozen")
+}
+
+func TestChannel_Disable(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	mustNoError(t, ch.Disable(), "disable channel")
+	assert.True(t, ch.Disabled)
+
+	_, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+	mustError(t, err, "send message to disabled channel")
+
+	mustNoError(t, ch.Enable(), "enable channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.False(t, ch.Snapshot().Disabled, "channel is enabled")
+}
+
+func TestClient_CreateChannel_distinct(t *testing.T) {
+	c := initClient(t)
+
+	users := randomUsers(2)
+	frodo, sam := users[0], users[1]
+	members := []string{frodo.ID, sam.ID}
+
+	ch, err := c.CreateChannel("messaging", "", frodo.ID, map[string]interface{}{"members": members})
+	mustNoError(t, err, "create distinct channel")
+	defer ch.Delete(false)
+
+	assert.NotEmpty(t, ch.ID, "ID is generated")
+	assert.Equal(t, "messaging:"+ch.ID, ch.CID)
+
+	again, err := c.CreateChannel("messaging", "", sam.ID, map[string]interface{}{"members": []string{sam.ID, frodo.ID}})
+	mustNoError(t, err, "get distinct channel")
+	assert.Equal(t, ch.CID, again.CID, "same members get the same channel")
+
+	_, err = c.CreateChannel("messaging", "", frodo.ID, nil)
+	mustError(t, err, "no ID and no members")
+}
+
+func TestChannel_Archive(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	q := &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID, "archived": false},
+		UserID: user.ID,
+	}
+
+	mustNoError(t, ch.Archive(user.ID), "archive channel")
+
+	channels, err := c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Empty(t, channels, "archived channel is filtered out")
+
+	mustNoError(t, ch.Unarchive(user.ID), "unarchive channel")
+
+	channels, err = c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Len(t, channels, 1, "unarchived channel is returned")
+
+	mustError(t, ch.Archive(""), "empty user ID")
+}
+
+func TestChannel_Pin(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	q := &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID, "pinned": true},
+		UserID: user.ID,
+	}
+
+	mustNoError(t, ch.Pin(user.ID), "pin channel")
+
+	channels, err := c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Len(t, channels, 1, "pinned channel is returned")
+
+	mustNoError(t, ch.Unpin(user.ID), "unpin channel")
 
+	channels, err = c.QueryChannels(q)
+	mustNoError(t, err, "query channels")
+	assert.Empty(t, channels, "unpinned channel is filtered out")
 }
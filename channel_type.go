@@ -3,8 +3,6 @@ package stream_chat
 import (
 	"errors"
 	"net/http"
-	"net/url"
-	"path"
 	"time"
 )
 
@@ -16,6 +14,9 @@ const (
 	ModBehaviourFlag  modBehaviour = "flag"
 	ModBehaviourBlock modBehaviour = "block"
 
+	BlocklistBehaviorFlag  blocklistBehavior = "flag"
+	BlocklistBehaviorBlock blocklistBehavior = "block"
+
 	defaultMessageLength = 5000
 
 	MessageRetentionForever = "infinite"
@@ -23,6 +24,7 @@ const (
 
 type modType string
 type modBehaviour string
+type blocklistBehavior string
 
 type Permission struct {
 	Name   string `json:"name"`   // required
@@ -111,7 +113,7 @@ func (c *Client) GetChannelType(chanType string) (*ChannelType, error) {
 		return nil, errors.New("channel type is empty")
 	}
 
-	p := path.Join("channeltypes", url.PathEscape(chanType))
+	p := buildPath("channeltypes", chanType)
 
 	ct := ChannelType{}
 
@@ -129,12 +131,29 @@ func (c *Client) ListChannelTypes() (map[string]*ChannelType, error) {
 	return resp.ChannelTypes, err
 }
 
+// UpdateChannelType updates settings of the channel type
+// options: settings to change, ie {"blocklist": "profanity", "blocklist_behavior": BlocklistBehaviorBlock}
+// or {"automod": AutoModAI, "automod_behavior": ModBehaviourBlock,
+// "automod_thresholds": &Thresholds{Toxic: &LabelThresholds{Flag: 0.5, Block: 0.8}}}
+func (c *Client) UpdateChannelType(name string, options map[string]interface{}) error {
+	switch {
+	case name == "":
+		return errors.New("channel type name is empty")
+	case len(options) == 0:
+		return errors.New("options are empty")
+	}
+
+	p := buildPath("channeltypes", name)
+
+	return c.makeRequest(http.MethodPut, p, nil, options, nil)
+}
+
 func (c *Client) DeleteChannelType(ct string) error {
 	if ct == "" {
 		return errors.New("channel type is empty")
 	}
 
-	p := path.Join("channeltypes", url.PathEscape(ct))
+	p := buildPath("channeltypes", ct)
 
 	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
 }
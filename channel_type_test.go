@@ -40,3 +40,26 @@ func TestClient_ListChannelTypes(t *testing.T) {
 
 	assert.Contains(t, got, ct.Name)
 }
+
+func TestClient_UpdateChannelType(t *testing.T) {
+	c := initClient(t)
+
+	ct := prepareChannelType(t, c)
+	defer c.DeleteChannelType(ct.Name)
+
+	thresholds := &Thresholds{Toxic: &LabelThresholds{Flag: 0.5, Block: 0.8}}
+
+	err := c.UpdateChannelType(ct.Name, map[string]interface{}{
+		"automod":            AutoModAI,
+		"automod_behavior":   ModBehaviourBlock,
+		"automod_thresholds": thresholds,
+	})
+	mustNoError(t, err, "update channel type")
+
+	got, err := c.GetChannelType(ct.Name)
+	mustNoError(t, err, "get channel type")
+
+	assert.Equal(t, AutoModAI, got.Automod)
+	assert.Equal(t, ModBehaviourBlock, got.ModBehavior)
+	assert.Equal(t, thresholds, got.AutomodThresholds)
+}
@@ -4,10 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/getstream/easyjson"
@@ -21,12 +22,33 @@ const (
 )
 
 type Client struct {
-	BaseURL string
-	HTTP    *http.Client
+	BaseURL string       `json:"-"`
+	HTTP    *http.Client `json:"-"`
+
+	// BulkThrottle limits request rate of the bulk helpers, ThrottleNormal by default
+	BulkThrottle ThrottleProfile `json:"-"`
+
+	// Journal records mutating requests which failed transiently so they can be replayed, off by default.
+	// Journaled request bodies are not redacted, as they are needed to replay the requests.
+	Journal RequestJournal `json:"-"`
+
+	// Redactor strips sensitive data from response bodies included in errors, off by default
+	Redactor Redactor `json:"-"`
+
+	// CoalesceReads shares the response of identical GET requests made concurrently,
+	// ie many handlers querying the same channel type during a traffic spike; off by default
+	CoalesceReads bool `json:"-"`
 
 	apiKey    string
 	apiSecret []byte
 	authToken string
+
+	dataTypes   map[string]reflect.Type // channel custom data types by channel type
+	dataTypesMu *sync.RWMutex           // guards dataTypes, shared with the ReadOnly view
+
+	readOnly bool // refuse mutating requests, see ReadOnly
+
+	reads *readGroup // GET requests in flight, see CoalesceReads
 }
 
 func (c *Client) setHeaders(r *http.Request) {
@@ -42,8 +64,8 @@ func (c *Client) parseResponse(resp *http.Response, result easyjson.Unmarshaler)
 	}
 
 	if resp.StatusCode >= 399 {
-		msg, _ := ioutil.ReadAll(resp.Body)
-		return fmt.Errorf("chat-client: HTTP %s %s status %s: %s", resp.Request.Method, resp.Request.URL, resp.Status, string(msg))
+		body, _ := ioutil.ReadAll(resp.Body)
+		return responseError(resp, body, c.Redactor)
 	}
 
 	if result != nil {
@@ -53,6 +75,19 @@ func (c *Client) parseResponse(resp *http.Response, result easyjson.Unmarshaler)
 	return nil
 }
 
+// parseResponseBody is parseResponse for a response whose body was already read
+func (c *Client) parseResponseBody(resp *http.Response, body []byte, result easyjson.Unmarshaler) error {
+	if resp.StatusCode >= 399 {
+		return responseError(resp, body, c.Redactor)
+	}
+
+	if result != nil {
+		return easyjson.Unmarshal(body, result)
+	}
+
+	return nil
+}
+
 func (c *Client) requestURL(path string, values url.Values) (string, error) {
 	_url, err := url.Parse(c.BaseURL + "/" + path)
 	if err != nil {
@@ -71,12 +106,11 @@ func (c *Client) requestURL(path string, values url.Values) (string, error) {
 }
 
 func (c *Client) makeRequest(method string, path string, params url.Values, data interface{}, result easyjson.Unmarshaler) error {
-	_url, err := c.requestURL(path, params)
-	if err != nil {
-		return err
-	}
+	var (
+		body []byte
+		err  error
+	)
 
-	var body []byte
 	if m, ok := data.(easyjson.Marshaler); ok {
 		body, err = easyjson.Marshal(m)
 	} else {
@@ -87,23 +121,90 @@ func (c *Client) makeRequest(method string, path string, params url.Values, data
 		return err
 	}
 
+	readOnly := readOnlyRequest(method, path, body)
+	if c.readOnly && !readOnly {
+		return ErrReadOnly
+	}
+
+	var entry *JournalEntry
+	if c.Journal != nil && !readOnly {
+		// requestURL modifies params, so the entry is built before sending
+		entry = newJournalEntry(method, path, params, body)
+	}
+
+	status, err := c.send(method, path, params, body, result)
+	if err != nil && entry != nil && transientFailure(status) {
+		entry.Error = err.Error()
+		_ = c.Journal.Append(entry)
+	}
+
+	return err
+}
+
+// send makes the request and returns the response status, zero if no response was received
+func (c *Client) send(method string, path string, params url.Values, body []byte, result easyjson.Unmarshaler) (int, error) {
+	_url, err := c.requestURL(path, params)
+	if err != nil {
+		return 0, err
+	}
+
+	if method == http.MethodGet && c.CoalesceReads && c.reads != nil {
+		resp, respBody, err := c.reads.do(_url, func() (*http.Response, []byte, error) {
+			return c.fetch(method, _url, body)
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return resp.StatusCode, c.parseResponseBody(resp, respBody, result)
+	}
+
 	r, err := http.NewRequest(method, _url, bytes.NewReader(body))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	c.setHeaders(r)
 
 	resp, err := c.HTTP.Do(r)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	return resp.StatusCode, c.parseResponse(resp, result)
+}
+
+// fetch makes the request and reads the whole response body, so the response can be shared
+func (c *Client) fetch(method string, _url string, body []byte) (*http.Response, []byte, error) {
+	r, err := http.NewRequest(method, _url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.setHeaders(r)
+
+	resp, err := c.HTTP.Do(r)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer resp.Body.Close()
 
-	return c.parseResponse(resp, result)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, respBody, nil
 }
 
-// CreateToken creates new token for user with optional expire time
-func (c *Client) CreateToken(userID string, expire time.Time) ([]byte, error) {
+// NoExpiry is the expire time of tokens which never expire
+var NoExpiry = time.Time{}
+
+// CreateToken creates new token for user with expire time, NoExpiry for a token which never expires.
+// Expire times in the past are rejected.
+// Optional issuedAt sets the "iat" claim, pass a time slightly in the past,
+// ie time.Now().Add(-5*time.Second), to tolerate devices with skewed clocks.
+func (c *Client) CreateToken(userID string, expire time.Time, issuedAt ...time.Time) ([]byte, error) {
 	if userID == "" {
 		return nil, errors.New("user ID is empty")
 	}
@@ -112,10 +213,40 @@ func (c *Client) CreateToken(userID string, expire time.Time) ([]byte, error) {
 		"user_id": userID,
 	}
 
+	if len(issuedAt) > 0 && !issuedAt[0].IsZero() {
+		params["iat"] = issuedAt[0].Unix()
+	}
+
+	return c.createToken(params, expire)
+}
+
+// ExpiresIn returns token expire time which is d from now
+func ExpiresIn(d time.Duration) time.Time {
+	return time.Now().Add(d)
+}
+
+// CreateTeamToken creates new token for user restricted to the given teams with expire time, see CreateToken
+func (c *Client) CreateTeamToken(userID string, teams []string, expire time.Time) ([]byte, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case len(teams) == 0:
+		return nil, errors.New("teams are empty")
+	}
+
+	params := map[string]interface{}{
+		"user_id": userID,
+		"teams":   teams,
+	}
+
 	return c.createToken(params, expire)
 }
 
 func (c *Client) createToken(params map[string]interface{}, expire time.Time) ([]byte, error) {
+	if !expire.IsZero() && !expire.After(time.Now()) {
+		return nil, errors.New("token expire time is in the past")
+	}
+
 	var claims = jwt.Claims{
 		Set: params,
 	}
@@ -140,9 +271,13 @@ func NewClient(apiKey string, apiSecret []byte) (*Client, error) {
 		HTTP: &http.Client{
 			Timeout: defaultTimeout,
 		},
+		BulkThrottle: ThrottleNormal,
+		dataTypes:    make(map[string]reflect.Type),
+		dataTypesMu:  &sync.RWMutex{},
+		reads:        newReadGroup(),
 	}
 
-	token, err := client.createToken(map[string]interface{}{"server": true}, time.Time{})
+	token, err := client.createToken(map[string]interface{}{"server": true}, NoExpiry)
 	if err != nil {
 		return nil, err
 	}
@@ -45,25 +45,29 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, c.apiSecret, []byte(APISecret))
 	assert.NotEmpty(t, c.authToken)
 	assert.Equal(t, defaultTimeout, c.HTTP.Timeout)
+	assert.Equal(t, ThrottleNormal, c.BulkThrottle)
 	//	assert.Equal(t, defaultBaseURL, c.BaseURL, )
 }
 
 func Test_client_CreateToken(t *testing.T) {
 	c := initClient(t)
 
-	var expire = time.Now().Add(time.Hour)
+	var expire = ExpiresIn(time.Hour)
+	var issued = time.Now().Add(-5 * time.Second)
 	tt := []struct {
 		name   string
 		expire time.Time
+		issued time.Time
 	}{
-		{"token without expire", time.Time{}},
-		{"token with expire", expire},
+		{"token without expire", NoExpiry, time.Time{}},
+		{"token with expire", expire, time.Time{}},
+		{"token issued in the past", expire, issued},
 	}
 
 	for _, test := range tt {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			token, err := c.CreateToken(testUsers[0].ID, test.expire)
+			token, err := c.CreateToken(testUsers[0].ID, test.expire, test.issued)
 			mustNoError(t, err, "create token")
 
 			claims, err := jwt.HMACCheck(token, c.apiSecret)
@@ -74,8 +78,79 @@ func Test_client_CreateToken(t *testing.T) {
 				expiresIn = jwt.NewNumericTime(test.expire)
 			}
 
+			var issuedAt *jwt.NumericTime
+			if !test.issued.IsZero() {
+				issuedAt = jwt.NewNumericTime(test.issued.Truncate(time.Second))
+			}
+
 			assert.Equal(t, expiresIn, claims.Expires)
+			assert.Equal(t, issuedAt, claims.Issued)
 			assert.Equal(t, testUsers[0].ID, claims.Set["user_id"])
 		})
 	}
 }
+
+func TestClient_CreateToken_ExpireTime(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	expireClaim := func(t *testing.T, expire time.Time) *jwt.NumericTime {
+		token, err := c.CreateToken("frodo-baggins", expire)
+		mustNoError(t, err, "create token")
+
+		claims, err := jwt.HMACCheck(token, c.apiSecret)
+		mustNoError(t, err, "jwt check")
+
+		return claims.Expires
+	}
+
+	t.Run("no expiry", func(t *testing.T) {
+		assert.Nil(t, expireClaim(t, NoExpiry))
+	})
+
+	t.Run("expire in the past", func(t *testing.T) {
+		_, err := c.CreateToken("frodo-baggins", time.Now().Add(-time.Second))
+		mustError(t, err, "create token")
+
+		_, err = c.CreateTeamToken("frodo-baggins", []string{"red"}, time.Now().Add(-time.Second))
+		mustError(t, err, "create team token")
+	})
+
+	t.Run("time zones", func(t *testing.T) {
+		ny, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("time zone database is not available")
+		}
+
+		tests := []struct {
+			name   string
+			expire time.Time
+		}{
+			// clocks go from 01:59 EDT back to 01:00 EST, so 01:30 happens twice
+			{"ambiguous time at DST end", time.Date(2030, 11, 3, 1, 30, 0, 0, ny)},
+			// clocks go from 01:59 EST to 03:00 EDT, so 02:30 doesn't exist
+			{"skipped time at DST start", time.Date(2030, 3, 10, 2, 30, 0, 0, ny)},
+			{"summer time", time.Date(2030, 7, 1, 12, 0, 0, 0, ny)},
+		}
+
+		for _, test := range tests {
+			want := jwt.NewNumericTime(time.Unix(test.expire.Unix(), 0))
+
+			assert.Equal(t, want, expireClaim(t, test.expire), test.name)
+			assert.Equal(t, want, expireClaim(t, test.expire.UTC()), "%s in UTC", test.name)
+		}
+	})
+}
+
+func TestClient_CreateTeamToken(t *testing.T) {
+	c := initClient(t)
+
+	token, err := c.CreateTeamToken(testUsers[0].ID, []string{"red", "blue"}, time.Time{})
+	mustNoError(t, err, "create team token")
+
+	claims, err := jwt.HMACCheck(token, c.apiSecret)
+	mustNoError(t, err, "jwt check")
+
+	assert.Equal(t, testUsers[0].ID, claims.Set["user_id"])
+	assert.Equal(t, []interface{}{"red", "blue"}, claims.Set["teams"])
+}
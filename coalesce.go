@@ -0,0 +1,53 @@
+package stream_chat
+
+import (
+	"net/http"
+	"sync"
+)
+
+// readCall is a GET request in flight, shared by all callers of the same URL
+type readCall struct {
+	done chan struct{}  // closed when the result is set
+	resp *http.Response // body is already read into body
+	body []byte
+	err  error
+}
+
+// readGroup coalesces identical concurrent GET requests, see Client.CoalesceReads
+type readGroup struct {
+	mu    *sync.Mutex
+	calls map[string]*readCall
+}
+
+func newReadGroup() *readGroup {
+	return &readGroup{
+		mu:    &sync.Mutex{},
+		calls: make(map[string]*readCall),
+	}
+}
+
+// do calls fn for key unless a call for key is already in flight,
+// in which case it waits for that call and returns its result
+func (g *readGroup) do(key string, fn func() (*http.Response, []byte, error)) (*http.Response, []byte, error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.resp, call.body, call.err
+	}
+
+	call := &readCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.resp, call.body, call.err = fn()
+
+	// later requests for key are made anew, so results are never stale
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	close(call.done)
+
+	return call.resp, call.body, call.err
+}
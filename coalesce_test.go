@@ -0,0 +1,51 @@
+package stream_chat
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CoalesceReads(t *testing.T) {
+	var hits int32
+
+	release := make(chan struct{})
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"name": "messaging"}`))
+	})
+	c.CoalesceReads = true
+
+	var wg sync.WaitGroup
+
+	types := make([]*ChannelType, 5)
+	for i := range types {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ct, err := c.GetChannelType("messaging")
+			assert.NoError(t, err, "get channel type")
+			types[i] = ct
+		}(i)
+	}
+
+	// let all the requests join the one in flight
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, atomic.LoadInt32(&hits), "identical reads are coalesced")
+	for _, ct := range types {
+		assert.Equal(t, "messaging", ct.Name, "every caller gets the response")
+	}
+
+	_, err := c.GetChannelType("messaging")
+	mustNoError(t, err, "get channel type")
+	assert.EqualValues(t, 2, atomic.LoadInt32(&hits), "later reads are made anew")
+}
@@ -3,6 +3,7 @@ package stream_chat
 import (
 	"errors"
 	"net/http"
+	"sync"
 )
 
 const (
@@ -71,3 +72,32 @@ func (c *Client) DeleteDevice(userID string, deviceID string) error {
 
 	return c.makeRequest(http.MethodDelete, "devices", params, nil, nil)
 }
+
+// GetDevicesForUsers returns devices of each given user keyed by user ID, users without devices have empty lists.
+// Requests are throttled by client's BulkThrottle.
+func (c *Client) GetDevicesForUsers(userIDs ...string) (map[string][]*Device, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("user IDs are empty")
+	}
+
+	var mu sync.Mutex
+	result := make(map[string][]*Device, len(userIDs))
+
+	err := c.BulkThrottle.run(len(userIDs), func(i int) error {
+		devices, err := c.GetDevices(userIDs[i])
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		result[userIDs[i]] = devices
+		mu.Unlock()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
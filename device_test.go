@@ -36,3 +36,20 @@ func deviceIDExists(dev []*Device, id string) bool {
 	}
 	return false
 }
+
+func TestClient_GetDevicesForUsers(t *testing.T) {
+	c := initClient(t)
+
+	withDevice, withoutDevice := testUsers[0], testUsers[1]
+
+	dev := &Device{UserID: withDevice.ID, ID: randomString(12), PushProvider: PushProviderFirebase}
+	mustNoError(t, c.AddDevice(dev), "add device")
+	defer c.DeleteDevice(withDevice.ID, dev.ID)
+
+	got, err := c.GetDevicesForUsers(withDevice.ID, withoutDevice.ID)
+	mustNoError(t, err, "get devices for users")
+
+	assert.True(t, deviceIDExists(got[withDevice.ID], dev.ID), "device exists")
+	assert.Contains(t, got, withoutDevice.ID)
+	assert.Empty(t, got[withoutDevice.ID], "user without devices")
+}
@@ -0,0 +1,17 @@
+package stream_chat
+
+//go:generate go run ./internal/genendpoints
+
+// Endpoint is a REST endpoint of the chat API called by the client
+type Endpoint struct {
+	Method string `json:"method"`
+	Path   string `json:"path"` // path parameters are in braces, ie "users/{targetID}/export"
+	Func   string `json:"func"` // the calling function, ie "Client.ExportUser" or "Channel.Truncate"
+}
+
+// Endpoints returns the REST endpoints implemented by the client sorted by path and method,
+// a function calling several endpoints is listed for each of them.
+// The list is generated from the source by go generate.
+func Endpoints() []Endpoint {
+	return append([]Endpoint(nil), endpoints...)
+}
@@ -0,0 +1,106 @@
+// Code generated by genendpoints. DO NOT EDIT.
+
+package stream_chat
+
+var endpoints = []Endpoint{
+	{Method: "GET", Path: "app", Func: "Client.GetAppSettings"},
+	{Method: "PATCH", Path: "app", Func: "Client.UpdateAppSettings"},
+	{Method: "GET", Path: "blocklists", Func: "Client.ListBlocklists"},
+	{Method: "POST", Path: "blocklists", Func: "Client.CreateBlocklist"},
+	{Method: "DELETE", Path: "blocklists/{name}", Func: "Client.DeleteBlocklist"},
+	{Method: "GET", Path: "blocklists/{name}", Func: "Client.GetBlocklist"},
+	{Method: "PUT", Path: "blocklists/{name}", Func: "Client.UpdateBlocklist"},
+	{Method: "POST", Path: "channels", Func: "Client.CountChannels"},
+	{Method: "POST", Path: "channels", Func: "Client.QueryChannels"},
+	{Method: "POST", Path: "channels/delete", Func: "Client.DeleteChannels"},
+	{Method: "POST", Path: "channels/read", Func: "Client.MarkAllRead"},
+	{Method: "POST", Path: "channels/{type}/query", Func: "Channel.Refresh"},
+	{Method: "POST", Path: "channels/{type}/query", Func: "Client.CreateChannel"},
+	{Method: "POST", Path: "channels/{type}/query", Func: "Client.RedeemJoinToken"},
+	{Method: "DELETE", Path: "channels/{type}/{id}", Func: "Channel.Delete"},
+	{Method: "PATCH", Path: "channels/{type}/{id}", Func: "Channel.Disable"},
+	{Method: "PATCH", Path: "channels/{type}/{id}", Func: "Channel.Enable"},
+	{Method: "PATCH", Path: "channels/{type}/{id}", Func: "Channel.Freeze"},
+	{Method: "PATCH", Path: "channels/{type}/{id}", Func: "Channel.PartialUpdate"},
+	{Method: "PATCH", Path: "channels/{type}/{id}", Func: "Channel.Unfreeze"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.AcceptInvite"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.AddMembers"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.AddModerators"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.AssignRoles"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.DemoteModerators"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.InviteMembers"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.RejectInvite"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.RemoveMembers"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Channel.Update"},
+	{Method: "POST", Path: "channels/{type}/{id}", Func: "Client.RolloutConfigOverrides"},
+	{Method: "POST", Path: "channels/{type}/{id}/event", Func: "Channel.SendEvent"},
+	{Method: "POST", Path: "channels/{type}/{id}/hide", Func: "Channel.Hide"},
+	{Method: "PATCH", Path: "channels/{type}/{id}/member/{userID}", Func: "Channel.PartialUpdateMember"},
+	{Method: "POST", Path: "channels/{type}/{id}/message", Func: "Channel.SendMessage"},
+	{Method: "GET", Path: "channels/{type}/{id}/pending_messages", Func: "Channel.QueryPendingMessages"},
+	{Method: "GET", Path: "channels/{type}/{id}/pinned_messages", Func: "Channel.GetPinnedMessages"},
+	{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Channel.QueryWatchers"},
+	{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Channel.Refresh"},
+	{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Channel.RemoveReactionsOfType"},
+	{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Client.CreateChannel"},
+	{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Client.RedeemJoinToken"},
+	{Method: "POST", Path: "channels/{type}/{id}/read", Func: "Channel.MarkRead"},
+	{Method: "POST", Path: "channels/{type}/{id}/show", Func: "Channel.Show"},
+	{Method: "POST", Path: "channels/{type}/{id}/truncate", Func: "Channel.Truncate"},
+	{Method: "POST", Path: "channels/{type}/{id}/unread", Func: "Channel.MarkUnread"},
+	{Method: "GET", Path: "channeltypes", Func: "Client.ListChannelTypes"},
+	{Method: "POST", Path: "channeltypes", Func: "Client.CreateChannelType"},
+	{Method: "GET", Path: "channeltypes/{chanType}", Func: "Client.GetChannelType"},
+	{Method: "DELETE", Path: "channeltypes/{ct}", Func: "Client.DeleteChannelType"},
+	{Method: "PUT", Path: "channeltypes/{name}", Func: "Client.UpdateChannelType"},
+	{Method: "POST", Path: "check_push", Func: "Client.CheckPush"},
+	{Method: "POST", Path: "check_sns", Func: "Client.CheckSNS"},
+	{Method: "POST", Path: "check_sqs", Func: "Client.CheckSQS"},
+	{Method: "DELETE", Path: "devices", Func: "Client.DeleteDevice"},
+	{Method: "GET", Path: "devices", Func: "Client.GetDevices"},
+	{Method: "POST", Path: "devices", Func: "Client.AddDevice"},
+	{Method: "POST", Path: "export/users", Func: "Client.ExportUsers"},
+	{Method: "POST", Path: "export_channels", Func: "Client.ExportChannels"},
+	{Method: "GET", Path: "export_channels/{taskID}", Func: "Client.GetExportChannelsStatus"},
+	{Method: "GET", Path: "members", Func: "Channel.QueryMembers"},
+	{Method: "POST", Path: "messages/{messageID}/reaction", Func: "Channel.SendReaction"},
+	{Method: "DELETE", Path: "messages/{messageID}/reaction/{reactionType}", Func: "Channel.DeleteReaction"},
+	{Method: "GET", Path: "messages/{messageID}/reactions", Func: "Channel.GetReactions"},
+	{Method: "DELETE", Path: "messages/{msgID}", Func: "Client.DeleteMessage"},
+	{Method: "POST", Path: "messages/{msgID}", Func: "Client.UpdateMessage"},
+	{Method: "PUT", Path: "messages/{msgID}", Func: "Client.PartialUpdateMessage"},
+	{Method: "GET", Path: "messages/{parentID}/replies", Func: "Channel.GetReplies"},
+	{Method: "DELETE", Path: "moderation/ban", Func: "Client.UnBanUser"},
+	{Method: "POST", Path: "moderation/ban", Func: "Client.BanUser"},
+	{Method: "POST", Path: "moderation/check", Func: "Client.CheckModeration"},
+	{Method: "POST", Path: "moderation/flag", Func: "Client.FlagMessage"},
+	{Method: "POST", Path: "moderation/flag", Func: "Client.FlagUser"},
+	{Method: "GET", Path: "moderation/flags/message", Func: "Client.QueryMessageFlags"},
+	{Method: "POST", Path: "moderation/mute", Func: "Client.MuteUser"},
+	{Method: "POST", Path: "moderation/mute", Func: "Client.MuteUsers"},
+	{Method: "POST", Path: "moderation/mute/channel", Func: "Client.MuteChannel"},
+	{Method: "POST", Path: "moderation/reports", Func: "Client.QueryFlagReports"},
+	{Method: "PATCH", Path: "moderation/reports/{id}", Func: "Client.ReviewFlagReport"},
+	{Method: "POST", Path: "moderation/unflag", Func: "Client.UnFlagMessage"},
+	{Method: "POST", Path: "moderation/unflag", Func: "Client.UnFlagUser"},
+	{Method: "POST", Path: "moderation/unmute", Func: "Client.UnmuteUser"},
+	{Method: "POST", Path: "moderation/unmute/channel", Func: "Client.UnmuteChannel"},
+	{Method: "GET", Path: "query_banned_users", Func: "Client.QueryBannedUsers"},
+	{Method: "GET", Path: "search", Func: "Client.TailMessages"},
+	{Method: "GET", Path: "tasks/{taskID}", Func: "Client.GetTask"},
+	{Method: "GET", Path: "users", Func: "Client.QueryUsers"},
+	{Method: "PATCH", Path: "users", Func: "Client.PartialUpdateUsers"},
+	{Method: "POST", Path: "users", Func: "Client.ImportUsers"},
+	{Method: "POST", Path: "users", Func: "Client.UpsertUsers"},
+	{Method: "GET", Path: "users/block", Func: "Client.GetBlockedUsers"},
+	{Method: "POST", Path: "users/block", Func: "Client.BlockUser"},
+	{Method: "POST", Path: "users/deactivate", Func: "Client.DeactivateUsers"},
+	{Method: "POST", Path: "users/delete", Func: "Client.DeleteUsers"},
+	{Method: "POST", Path: "users/reactivate", Func: "Client.ReactivateUsers"},
+	{Method: "POST", Path: "users/unblock", Func: "Client.UnblockUser"},
+	{Method: "DELETE", Path: "users/{targetID}", Func: "Client.DeleteUser"},
+	{Method: "POST", Path: "users/{targetID}/deactivate", Func: "Client.DeactivateUser"},
+	{Method: "GET", Path: "users/{targetID}/export", Func: "Client.ExportUser"},
+	{Method: "POST", Path: "users/{targetID}/reactivate", Func: "Client.ReactivateUser"},
+	{Method: "POST", Path: "users/{targetUserID}/event", Func: "Client.SendUserCustomEvent"},
+}
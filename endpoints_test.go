@@ -0,0 +1,27 @@
+package stream_chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoints(t *testing.T) {
+	endpoints := Endpoints()
+
+	assert.Contains(t, endpoints, Endpoint{Method: "POST", Path: "moderation/ban", Func: "Client.BanUser"})
+	assert.Contains(t, endpoints, Endpoint{Method: "POST", Path: "channels/{type}/{id}/truncate", Func: "Channel.Truncate"})
+	assert.Contains(t, endpoints, Endpoint{Method: "POST", Path: "users", Func: "Client.UpsertUsers"}, "requests of helpers are listed")
+	assert.Contains(t, endpoints, Endpoint{Method: "POST", Path: "channels/{type}/{id}/query", Func: "Client.CreateChannel"},
+		"every path assigned to a variable is listed")
+	assert.Contains(t, endpoints, Endpoint{Method: "PATCH", Path: "moderation/reports/{id}", Func: "Client.ReviewFlagReport"},
+		"field parameters are lowercase")
+
+	for _, e := range endpoints {
+		assert.NotEmpty(t, e.Method, "method of %s", e.Func)
+		assert.NotEmpty(t, e.Path, "path of %s", e.Func)
+	}
+
+	endpoints[0].Path = "changed"
+	assert.NotEqual(t, "changed", Endpoints()[0].Path, "returns a copy")
+}
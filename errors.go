@@ -0,0 +1,98 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError is returned when the API responds with HTTP 429 Too Many Requests
+type RateLimitError struct {
+	Limit     int       // requests allowed per window, zero if unknown
+	Remaining int       // requests left in the current window
+	Reset     time.Time // when the current window resets, zero if unknown
+
+	// RetryAfter is how long to wait before the next request, zero if unknown
+	RetryAfter time.Duration
+
+	msg string
+}
+
+func (e *RateLimitError) Error() string {
+	return e.msg
+}
+
+type rateLimitBody struct {
+	RetryAfter float64 `json:"retry_after"` // seconds
+	Reset      int64   `json:"reset"`       // unix time, seconds
+}
+
+// newRateLimitError collects rate limit details from response headers, falling back to the response body
+func newRateLimitError(resp *http.Response, body []byte, msg string, now time.Time) *RateLimitError {
+	e := &RateLimitError{msg: msg}
+
+	h := resp.Header
+	e.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	e.Remaining, _ = strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset > 0 {
+		e.Reset = time.Unix(reset, 0)
+	}
+	if secs, err := strconv.Atoi(h.Get("Retry-After")); err == nil && secs > 0 {
+		e.RetryAfter = time.Duration(secs) * time.Second
+	}
+
+	var b rateLimitBody
+	if err := json.Unmarshal(body, &b); err == nil {
+		if e.Reset.IsZero() && b.Reset > 0 {
+			e.Reset = time.Unix(b.Reset, 0)
+		}
+		if e.RetryAfter == 0 && b.RetryAfter > 0 {
+			e.RetryAfter = time.Duration(b.RetryAfter * float64(time.Second))
+		}
+	}
+
+	if e.RetryAfter == 0 && !e.Reset.IsZero() && e.Reset.After(now) {
+		e.RetryAfter = e.Reset.Sub(now)
+	}
+
+	return e
+}
+
+// responseError returns the error of unsuccessful response, the body in error message is redacted by r if set
+func responseError(resp *http.Response, body []byte, r Redactor) error {
+	shown := body
+	if r != nil {
+		shown = r.Redact(body)
+	}
+
+	// the query is left out, it holds the API key and GET payloads
+	u := *resp.Request.URL
+	u.RawQuery = ""
+
+	msg := fmt.Sprintf("chat-client: HTTP %s %s status %s: %s", resp.Request.Method, u.String(), resp.Status, string(shown))
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return newRateLimitError(resp, body, msg, time.Now())
+	}
+
+	return &statusError{status: resp.StatusCode, msg: msg}
+}
+
+// statusError is returned for unsuccessful responses other than 429
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string {
+	return e.msg
+}
+
+// isNotFound reports whether err is an API response with HTTP 404 Not Found
+func isNotFound(err error) bool {
+	e, ok := err.(*statusError)
+	return ok && e.status == http.StatusNotFound
+}
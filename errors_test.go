@@ -0,0 +1,96 @@
+package stream_chat
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_responseError_rateLimit(t *testing.T) {
+	now := time.Unix(1566000000, 0)
+
+	newResponse := func(header http.Header) *http.Response {
+		return &http.Response{
+			Status:     "429 Too Many Requests",
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/channels"}},
+		}
+	}
+
+	t.Run("headers", func(t *testing.T) {
+		resp := newResponse(http.Header{
+			"X-Ratelimit-Limit":     {"60"},
+			"X-Ratelimit-Remaining": {"0"},
+			"X-Ratelimit-Reset":     {"1566000030"},
+		})
+
+		err := newRateLimitError(resp, []byte(`{"message":"Too many requests"}`), "msg", now)
+
+		assert.Equal(t, 60, err.Limit)
+		assert.Equal(t, 0, err.Remaining)
+		assert.Equal(t, time.Unix(1566000030, 0), err.Reset)
+		assert.Equal(t, 30*time.Second, err.RetryAfter)
+	})
+
+	t.Run("retry after header wins", func(t *testing.T) {
+		resp := newResponse(http.Header{
+			"Retry-After":       {"5"},
+			"X-Ratelimit-Reset": {"1566000030"},
+		})
+
+		err := newRateLimitError(resp, nil, "msg", now)
+
+		assert.Equal(t, 5*time.Second, err.RetryAfter)
+	})
+
+	t.Run("body only", func(t *testing.T) {
+		resp := newResponse(http.Header{})
+
+		err := newRateLimitError(resp, []byte(`{"retry_after":1.5,"reset":1566000060}`), "msg", now)
+
+		assert.Equal(t, time.Unix(1566000060, 0), err.Reset)
+		assert.Equal(t, 1500*time.Millisecond, err.RetryAfter)
+	})
+
+	t.Run("error type and message", func(t *testing.T) {
+		err := responseError(newResponse(http.Header{}), []byte("slow down"), nil)
+
+		if assert.IsType(t, &RateLimitError{}, err) {
+			assert.Equal(t, "chat-client: HTTP GET /channels status 429 Too Many Requests: slow down", err.Error())
+		}
+	})
+}
+
+func Test_responseError_redacted(t *testing.T) {
+	resp := &http.Response{
+		Status:     "400 Bad Request",
+		StatusCode: http.StatusBadRequest,
+		Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/channels/messaging/general/message"}},
+	}
+	body := []byte(`{"message":{"text":"my card is 4111","user":{"id":"frodo","email":"frodo@shire.me"}},"code":4}`)
+
+	err := responseError(resp, body, RedactFields("text", "email"))
+
+	assert.Equal(t, `chat-client: HTTP POST /channels/messaging/general/message status 400 Bad Request: `+
+		`{"code":4,"message":{"text":"[redacted]","user":{"email":"[redacted]","id":"frodo"}}}`, err.Error())
+}
+
+func Test_responseError_query(t *testing.T) {
+	u, err := url.Parse("https://chat.stream-io-api.com/users?api_key=secret-key&payload=%7B%22filter_conditions%22%3A%7B%22email%22%3A%22frodo%40shire.me%22%7D%7D")
+	mustNoError(t, err, "parse url")
+
+	resp := &http.Response{
+		Status:     "400 Bad Request",
+		StatusCode: http.StatusBadRequest,
+		Request:    &http.Request{Method: http.MethodGet, URL: u},
+	}
+
+	err = responseError(resp, []byte(`{"code":4}`), nil)
+
+	assert.Equal(t, `chat-client: HTTP GET https://chat.stream-io-api.com/users status 400 Bad Request: {"code":4}`, err.Error())
+	assert.Contains(t, u.RawQuery, "api_key", "request URL is not changed")
+}
@@ -3,8 +3,6 @@ package stream_chat
 import (
 	"errors"
 	"net/http"
-	"net/url"
-	"path"
 	"time"
 )
 
@@ -50,7 +48,8 @@ type Event struct {
 	OwnUser      *User          `json:"me,omitempty"`
 	WatcherCount int            `json:"watcher_count,omitempty"`
 
-	ExtraData map[string]interface{} `json:"-"`
+	// any other fields the user wants to attach to a custom event
+	ExtraData map[string]interface{} `json:"-,extra"`
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 }
@@ -59,17 +58,55 @@ type eventRequest struct {
 	Event *Event `json:"event"`
 }
 
-// SendEvent sends an event on this channel
+// SendEvent sends an event on this channel on behalf of the user with given ID.
+// Besides Event* constants, any custom event type can be sent, custom payload goes to event's ExtraData.
 func (ch *Channel) SendEvent(event *Event, userID string) error {
-	if event == nil {
+	switch {
+	case event == nil:
 		return errors.New("event is nil")
+	case event.Type == "":
+		return errors.New("event type is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
 	}
 
 	event.User = &User{ID: userID}
 
 	req := eventRequest{Event: event}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "event")
+	p := ch.path("event")
 
 	return ch.client.makeRequest(http.MethodPost, p, nil, req, nil)
 }
+
+// UserCustomEvent is a custom event sent to a single user's active connections.
+type UserCustomEvent struct {
+	Type string `json:"type"` // custom event type, ie "friendship_request"
+
+	// custom payload of the event
+	ExtraData map[string]interface{} `json:"-,extra"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+type userCustomEventRequest struct {
+	Event *UserCustomEvent `json:"event"`
+}
+
+// SendUserCustomEvent sends a custom event to all connections of the target user
+func (c *Client) SendUserCustomEvent(targetUserID string, event *UserCustomEvent) error {
+	switch {
+	case targetUserID == "":
+		return errors.New("target user ID is empty")
+	case event == nil:
+		return errors.New("event is nil")
+	case event.Type == "":
+		return errors.New("event type is empty")
+	}
+
+	req := userCustomEventRequest{Event: event}
+
+	p := buildPath("users", targetUserID, "event")
+
+	return c.makeRequest(http.MethodPost, p, nil, req, nil)
+}
@@ -0,0 +1,22 @@
+package stream_chat
+
+import (
+	"testing"
+)
+
+func TestClient_SendUserCustomEvent(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+	_, err := c.UpdateUsers(user)
+	mustNoError(t, err, "update users")
+
+	err = c.SendUserCustomEvent(user.ID, &UserCustomEvent{
+		Type:      "friendship_request",
+		ExtraData: map[string]interface{}{"from_user_id": serverUser.ID},
+	})
+	mustNoError(t, err, "send user custom event")
+
+	err = c.SendUserCustomEvent(user.ID, &UserCustomEvent{})
+	mustError(t, err, "send user custom event without type")
+}
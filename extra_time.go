@@ -0,0 +1,48 @@
+package stream_chat
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExtraTime returns the time stored in extra data under key.
+// Times are sent as strings and decoded back as strings, ExtraTime parses them with the layouts,
+// time.RFC3339Nano if none are given. The zone offset of the stored time is preserved.
+// For typed custom data see RegisterChannelDataType.
+func ExtraTime(extra map[string]interface{}, key string, layouts ...string) (time.Time, error) {
+	v, ok := extra[key]
+	if !ok {
+		return time.Time{}, fmt.Errorf("extra data %q is not set", key)
+	}
+
+	switch v := v.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		if len(layouts) == 0 {
+			layouts = []string{time.RFC3339Nano}
+		}
+
+		var err error
+		for _, layout := range layouts {
+			var t time.Time
+			if t, err = time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+
+		return time.Time{}, fmt.Errorf("extra data %q: %v", key, err)
+	}
+
+	return time.Time{}, fmt.Errorf("extra data %q is %T, not a time", key, v)
+}
+
+// SetExtraTime stores t in extra data under key formatted with layout, time.RFC3339Nano if empty,
+// ie to keep a date format other clients of the custom field expect
+func SetExtraTime(extra map[string]interface{}, key string, t time.Time, layout string) {
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+
+	extra[key] = t.Format(layout)
+}
@@ -0,0 +1,50 @@
+package stream_chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getstream/easyjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtraTime(t *testing.T) {
+	starts := time.Date(2019, 9, 1, 18, 30, 0, 500, time.FixedZone("CEST", 2*60*60))
+
+	ev := Event{Type: "event.scheduled", ExtraData: map[string]interface{}{"starts_at": starts}}
+
+	data, err := easyjson.Marshal(&ev)
+	mustNoError(t, err, "marshal event")
+
+	var got Event
+	mustNoError(t, easyjson.Unmarshal(data, &got), "unmarshal event")
+
+	at, err := ExtraTime(got.ExtraData, "starts_at")
+	mustNoError(t, err, "extra time")
+
+	assert.True(t, starts.Equal(at), "same instant")
+	_, offset := at.Zone()
+	assert.Equal(t, 2*60*60, offset, "zone offset is kept")
+
+	at, err = ExtraTime(ev.ExtraData, "starts_at")
+	mustNoError(t, err, "extra time before encoding")
+	assert.Equal(t, starts, at)
+
+	_, err = ExtraTime(got.ExtraData, "ends_at")
+	mustError(t, err, "not set")
+}
+
+func TestSetExtraTime(t *testing.T) {
+	extra := map[string]interface{}{}
+	day := time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	SetExtraTime(extra, "day", day, "2006-01-02")
+	assert.Equal(t, "2019-09-01", extra["day"])
+
+	got, err := ExtraTime(extra, "day", time.RFC3339, "2006-01-02")
+	mustNoError(t, err, "extra time with custom layout")
+	assert.Equal(t, day, got)
+
+	_, err = ExtraTime(extra, "day")
+	mustError(t, err, "default layout doesn't match")
+}
@@ -0,0 +1,269 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type FlagResolution string
+
+const (
+	FlagResolutionDismiss       FlagResolution = "dismiss"        // remove the flag, keep the message
+	FlagResolutionDeleteMessage FlagResolution = "delete_message" // remove the flag and delete the message
+	FlagResolutionShadowBan     FlagResolution = "shadow_ban"     // remove the flag and shadow ban the message author
+	FlagResolutionBan           FlagResolution = "ban"            // ban the message author, only for flag reports
+)
+
+type MessageFlagResolution struct {
+	MessageID   string         // ID of the flagged message
+	AuthorID    string         // ID of the message author, required for FlagResolutionShadowBan
+	FlaggedByID string         // ID of the user who flagged the message
+	ModeratorID string         // ID of the moderator resolving the flag, required for FlagResolutionShadowBan
+	Action      FlagResolution // FlagResolutionDismiss, FlagResolutionDeleteMessage or FlagResolutionShadowBan
+}
+
+// FlagMessage flags the message for review in the moderation queue
+// userID: user who flags the message
+// options: additional flag options, ie {"reason": "spam", "custom": {"score": 0.9}}
+func (c *Client) FlagMessage(msgID string, userID string, options map[string]interface{}) error {
+	switch {
+	case msgID == "":
+		return errors.New("message ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"target_message_id": msgID,
+		"user_id":           userID,
+	}
+
+	for k, v := range options {
+		data[k] = v
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/flag", nil, data, nil)
+}
+
+// UnFlagMessage removes the flag userID raised on the message
+func (c *Client) UnFlagMessage(msgID string, userID string) error {
+	switch {
+	case msgID == "":
+		return errors.New("message ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"target_message_id": msgID,
+		"user_id":           userID,
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/unflag", nil, data, nil)
+}
+
+// ResolveMessageFlag dismisses the flag on a message and applies the resolution action.
+// The flag is dismissed first; if the action fails afterwards, the flag is raised again
+// so the message stays in the moderation queue.
+func (c *Client) ResolveMessageFlag(r *MessageFlagResolution) error {
+	switch {
+	case r == nil:
+		return errors.New("flag resolution is nil")
+	case r.MessageID == "":
+		return errors.New("message ID is empty")
+	case r.FlaggedByID == "":
+		return errors.New("flagged by user ID is empty")
+	case r.Action == FlagResolutionShadowBan && r.AuthorID == "":
+		return errors.New("author ID is empty")
+	case r.Action == FlagResolutionShadowBan && r.ModeratorID == "":
+		return errors.New("moderator ID is empty")
+	}
+
+	var action func() error
+
+	switch r.Action {
+	case FlagResolutionDismiss:
+	case FlagResolutionDeleteMessage:
+		action = func() error {
+			return c.DeleteMessage(r.MessageID)
+		}
+	case FlagResolutionShadowBan:
+		action = func() error {
+			return c.ShadowBan(r.AuthorID, r.ModeratorID, nil)
+		}
+	default:
+		return fmt.Errorf("unknown flag resolution %q", r.Action)
+	}
+
+	if err := c.UnFlagMessage(r.MessageID, r.FlaggedByID); err != nil {
+		return err
+	}
+
+	if action == nil {
+		return nil
+	}
+
+	if err := action(); err != nil {
+		if cerr := c.FlagMessage(r.MessageID, r.FlaggedByID, nil); cerr != nil {
+			return fmt.Errorf("%s: %v (restoring flag failed: %v)", r.Action, err, cerr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MessageFlag is a flag raised on a message
+type MessageFlag struct {
+	CreatedByAutomod bool     `json:"created_by_automod"`
+	User             *User    `json:"user"`    // user who flagged the message
+	Message          *Message `json:"message"` // flagged message
+
+	ReviewedBy *User      `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	RejectedAt *time.Time `json:"rejected_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type queryMessageFlagsRequest struct {
+	FilterConditions map[string]interface{} `json:"filter_conditions,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+type queryMessageFlagsResponse struct {
+	Flags []*MessageFlag `json:"flags"`
+}
+
+// QueryMessageFlags returns flags raised on messages that match QueryOption,
+// ie {"channel_cid": "messaging:general", "is_reviewed": false} for the outstanding flags of a channel.
+// Flags can be filtered by channel_cid, user_id (author of the message) and is_reviewed.
+func (c *Client) QueryMessageFlags(q *QueryOption) ([]*MessageFlag, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	req := queryMessageFlagsRequest{
+		FilterConditions: q.Filter,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"payload": {string(payload)}}
+
+	var resp queryMessageFlagsResponse
+
+	err = c.makeRequest(http.MethodGet, "moderation/flags/message", params, nil, &resp)
+
+	return resp.Flags, err
+}
+
+// FlagReport groups the flags raised on a message
+type FlagReport struct {
+	ID            string   `json:"id"`
+	Message       *Message `json:"message"`
+	FlagsCount    int      `json:"flags_count"`
+	MessageUserID string   `json:"message_user_id"` // author of the reported message
+	ChannelCID    string   `json:"channel_cid"`
+
+	ReviewResult string     `json:"review_result,omitempty"`
+	ReviewedBy   *User      `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type queryFlagReportsResponse struct {
+	FlagReports []*FlagReport `json:"flag_reports"`
+}
+
+// QueryFlagReports returns flag reports that match QueryOption,
+// ie {"channel_cid": "messaging:general", "review_result": {"$exists": false}} for the open reports of a channel.
+func (c *Client) QueryFlagReports(q *QueryOption) ([]*FlagReport, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	req := queryMessageFlagsRequest{
+		FilterConditions: q.Filter,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	var resp queryFlagReportsResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/reports", nil, req, &resp)
+
+	return resp.FlagReports, err
+}
+
+type flagReportResponse struct {
+	FlagReport *FlagReport `json:"flag_report"`
+}
+
+// ReviewFlagReport applies the action to the reported message and marks the report reviewed by userID.
+// action: FlagResolutionDismiss, FlagResolutionDeleteMessage or FlagResolutionBan
+// details: optional review details stored with the report, ie {"reason": "spam"}
+// The report stays open if the action fails.
+func (c *Client) ReviewFlagReport(report *FlagReport, userID string, action FlagResolution, details map[string]interface{}) (*FlagReport, error) {
+	switch {
+	case report == nil:
+		return nil, errors.New("flag report is nil")
+	case report.ID == "":
+		return nil, errors.New("flag report ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	var err error
+
+	switch action {
+	case FlagResolutionDismiss:
+	case FlagResolutionDeleteMessage:
+		if report.Message == nil || report.Message.ID == "" {
+			return nil, errors.New("reported message ID is empty")
+		}
+		err = c.DeleteMessage(report.Message.ID)
+	case FlagResolutionBan:
+		if report.MessageUserID == "" {
+			return nil, errors.New("reported message user ID is empty")
+		}
+		err = c.BanUser(report.MessageUserID, userID, nil)
+	default:
+		return nil, fmt.Errorf("unsupported flag report action %q", action)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"review_result": action,
+		"user_id":       userID,
+	}
+	if len(details) > 0 {
+		data["review_details"] = details
+	}
+
+	p := buildPath("moderation", "reports", report.ID)
+
+	var resp flagReportResponse
+
+	err = c.makeRequest(http.MethodPatch, p, nil, data, &resp)
+
+	return resp.FlagReport, err
+}
@@ -0,0 +1,98 @@
+package stream_chat
+
+import (
+	"sort"
+	"time"
+)
+
+const flagsPageSize = 100
+
+// FlagCount is the number of flags raised on a target
+type FlagCount struct {
+	TargetID string    // ID of the flagged message or of the author of flagged messages
+	Count    int       // number of flags
+	LastAt   time.Time // when the latest flag was raised
+}
+
+// FlagAggregate holds flag counts grouped by target, most flagged first
+type FlagAggregate struct {
+	Messages []*FlagCount // by flagged message
+	Users    []*FlagCount // by author of flagged messages
+}
+
+// AggregateMessageFlags counts message flags matching the filter raised at or after since,
+// grouped by message and by message author, ie to escalate once a target crosses a threshold.
+// filter: see QueryMessageFlags, nil for all flags; a created_at condition replaces the one built from since
+// All matching flags are paged through, 100 per request.
+func (c *Client) AggregateMessageFlags(filter map[string]interface{}, since time.Time) (*FlagAggregate, error) {
+	f := map[string]interface{}{
+		"created_at": map[string]interface{}{"$gte": since.UTC().Format(time.RFC3339Nano)},
+	}
+	for k, v := range filter {
+		f[k] = v
+	}
+
+	messages := map[string]*FlagCount{}
+	users := map[string]*FlagCount{}
+
+	add := func(counts map[string]*FlagCount, id string, at time.Time) {
+		if id == "" {
+			return
+		}
+
+		fc, ok := counts[id]
+		if !ok {
+			fc = &FlagCount{TargetID: id}
+			counts[id] = fc
+		}
+
+		fc.Count++
+		if at.After(fc.LastAt) {
+			fc.LastAt = at
+		}
+	}
+
+	for offset := 0; ; offset += flagsPageSize {
+		flags, err := c.QueryMessageFlags(&QueryOption{Filter: f, Limit: flagsPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range flags {
+			if f.Message == nil || f.CreatedAt.Before(since) {
+				continue
+			}
+
+			add(messages, f.Message.ID, f.CreatedAt)
+			if f.Message.User != nil {
+				add(users, f.Message.User.ID, f.CreatedAt)
+			}
+		}
+
+		if len(flags) < flagsPageSize {
+			break
+		}
+	}
+
+	return &FlagAggregate{
+		Messages: sortedFlagCounts(messages),
+		Users:    sortedFlagCounts(users),
+	}, nil
+}
+
+// sortedFlagCounts returns counts with most flagged targets first
+func sortedFlagCounts(counts map[string]*FlagCount) []*FlagCount {
+	result := make([]*FlagCount, 0, len(counts))
+	for _, fc := range counts {
+		result = append(result, fc)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].TargetID < result[j].TargetID
+	})
+
+	return result
+}
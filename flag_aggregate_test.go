@@ -0,0 +1,73 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_AggregateMessageFlags(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	author := testUsers[0]
+
+	first, err := ch.SendMessage(&Message{Text: "first message"}, author.ID)
+	mustNoError(t, err, "send message")
+	second, err := ch.SendMessage(&Message{Text: "second message"}, author.ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, c.FlagMessage(first.ID, testUsers[1].ID, nil), "flag message")
+	mustNoError(t, c.FlagMessage(second.ID, testUsers[1].ID, nil), "flag message")
+	mustNoError(t, c.FlagMessage(second.ID, testUsers[2].ID, nil), "flag message")
+
+	agg, err := c.AggregateMessageFlags(map[string]interface{}{"channel_cid": ch.CID}, first.CreatedAt)
+	mustNoError(t, err, "aggregate message flags")
+
+	if assert.Len(t, agg.Messages, 2) {
+		assert.Equal(t, second.ID, agg.Messages[0].TargetID, "most flagged message is first")
+		assert.Equal(t, 2, agg.Messages[0].Count)
+	}
+	if assert.Len(t, agg.Users, 1) {
+		assert.Equal(t, author.ID, agg.Users[0].TargetID)
+		assert.Equal(t, 3, agg.Users[0].Count)
+	}
+}
+
+func TestSortedFlagCounts(t *testing.T) {
+	counts := map[string]*FlagCount{
+		"b": {TargetID: "b", Count: 1},
+		"c": {TargetID: "c", Count: 3},
+		"a": {TargetID: "a", Count: 1},
+	}
+
+	var ids []string
+	for _, fc := range sortedFlagCounts(counts) {
+		ids = append(ids, fc.TargetID)
+	}
+
+	assert.Equal(t, []string{"c", "a", "b"}, ids)
+}
+
+func TestClient_AggregateMessageFlags_filter(t *testing.T) {
+	var payload map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.Unmarshal([]byte(r.URL.Query().Get("payload")), &payload)
+		_, _ = w.Write([]byte(`{"flags":[]}`))
+	})
+
+	since := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := c.AggregateMessageFlags(map[string]interface{}{"channel_cid": "messaging:general"}, since)
+	mustNoError(t, err, "aggregate flags")
+
+	assert.Equal(t, map[string]interface{}{
+		"channel_cid": "messaging:general",
+		"created_at":  map[string]interface{}{"$gte": "2020-01-01T12:00:00Z"},
+	}, payload["filter_conditions"], "since is sent to the server")
+}
@@ -0,0 +1,138 @@
+package stream_chat
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ResolveMessageFlag(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	author := testUsers[0]
+	reporter := testUsers[1]
+
+	tests := []FlagResolution{
+		FlagResolutionDismiss,
+		FlagResolutionShadowBan,
+		FlagResolutionDeleteMessage,
+	}
+
+	for _, action := range tests {
+		action := action
+		t.Run(string(action), func(t *testing.T) {
+			msg, err := ch.SendMessage(&Message{Text: "test message"}, author.ID)
+			mustNoError(t, err, "send message")
+
+			mustNoError(t, c.FlagMessage(msg.ID, reporter.ID, nil), "flag message")
+
+			err = c.ResolveMessageFlag(&MessageFlagResolution{
+				MessageID:   msg.ID,
+				AuthorID:    author.ID,
+				FlaggedByID: reporter.ID,
+				ModeratorID: serverUser.ID,
+				Action:      action,
+			})
+			mustNoError(t, err, "resolve message flag")
+
+			if action == FlagResolutionShadowBan {
+				mustNoError(t, c.UnBanUser(author.ID, nil), "unban user")
+			}
+		})
+	}
+}
+
+func TestClient_ResolveMessageFlag_Validation(t *testing.T) {
+	var unflagged bool
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		unflagged = r.URL.Path == "/moderation/unflag"
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := c.ResolveMessageFlag(&MessageFlagResolution{MessageID: "msg-1", FlaggedByID: "sam", Action: FlagResolutionDismiss})
+	mustNoError(t, err, "dismiss without moderator")
+	assert.True(t, unflagged, "flag is dismissed")
+
+	err = c.ResolveMessageFlag(&MessageFlagResolution{MessageID: "msg-1", FlaggedByID: "sam", AuthorID: "frodo", Action: FlagResolutionShadowBan})
+	mustError(t, err, "shadow ban without moderator")
+
+	err = c.ResolveMessageFlag(&MessageFlagResolution{MessageID: "msg-1", FlaggedByID: "sam", ModeratorID: "gandalf", Action: FlagResolutionBan})
+	mustError(t, err, "ban is only for flag reports")
+}
+
+func TestClient_FlagMessage(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, testUsers[0].ID)
+	mustNoError(t, err, "send message")
+
+	err = c.FlagMessage(msg.ID, testUsers[1].ID, map[string]interface{}{"reason": "spam"})
+	mustNoError(t, err, "flag message")
+
+	err = c.UnFlagMessage(msg.ID, testUsers[1].ID)
+	mustNoError(t, err, "unflag message")
+}
+
+func TestClient_QueryMessageFlags(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, testUsers[0].ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, c.FlagMessage(msg.ID, testUsers[1].ID, nil), "flag message")
+
+	flags, err := c.QueryMessageFlags(&QueryOption{
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+		Limit:  10,
+	})
+	mustNoError(t, err, "query message flags")
+
+	if assert.Len(t, flags, 1) {
+		assert.Equal(t, msg.ID, flags[0].Message.ID)
+		assert.Equal(t, testUsers[1].ID, flags[0].User.ID)
+	}
+}
+
+func TestClient_ReviewFlagReport(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, testUsers[0].ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, c.FlagMessage(msg.ID, testUsers[1].ID, nil), "flag message")
+
+	reports, err := c.QueryFlagReports(&QueryOption{
+		Filter: map[string]interface{}{"message_id": msg.ID},
+	})
+	mustNoError(t, err, "query flag reports")
+
+	if !assert.Len(t, reports, 1) {
+		return
+	}
+
+	report, err := c.ReviewFlagReport(reports[0], serverUser.ID, FlagResolutionDeleteMessage, map[string]interface{}{"reason": "spam"})
+	mustNoError(t, err, "review flag report")
+
+	assert.Equal(t, string(FlagResolutionDeleteMessage), report.ReviewResult)
+}
+
+func TestClient_ReviewFlagReport_Validation(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	_, err = c.ReviewFlagReport(&FlagReport{ID: "report"}, "moderator", FlagResolutionShadowBan, nil)
+	mustError(t, err, "unsupported action")
+
+	_, err = c.ReviewFlagReport(&FlagReport{ID: "report"}, "moderator", FlagResolutionBan, nil)
+	mustError(t, err, "author is unknown")
+}
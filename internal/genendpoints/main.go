@@ -0,0 +1,298 @@
+// Command genendpoints writes endpoints_gen.go, the table of REST endpoints called by the package
+// in the current directory, see stream_chat.Endpoints.
+// Endpoints are found statically from makeRequest calls, requests made by unexported helpers
+// are attributed to the exported functions calling them.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const output = "endpoints_gen.go"
+
+var methods = map[string]string{
+	"MethodGet":    "GET",
+	"MethodHead":   "HEAD",
+	"MethodPost":   "POST",
+	"MethodPut":    "PUT",
+	"MethodPatch":  "PATCH",
+	"MethodDelete": "DELETE",
+}
+
+type endpoint struct {
+	Method string
+	Path   string
+	Func   string
+}
+
+// request is a makeRequest call, pathParam is the index of the function parameter used as path, or -1
+type request struct {
+	method    string
+	path      string
+	pathParam int
+}
+
+// call is a call of an unexported function with the literal arguments, "" for the others
+type call struct {
+	name string
+	args []string
+}
+
+type function struct {
+	name     string // Recv.Name or Name
+	exported bool
+	requests []request
+	calls    []call
+}
+
+func main() {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != output
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var funcs []*function
+	helpers := map[string]*function{} // unexported functions by name
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Body == nil {
+					continue
+				}
+
+				f := parseFunc(fd)
+				funcs = append(funcs, f)
+				if !f.exported {
+					helpers[fd.Name.Name] = f
+				}
+			}
+		}
+	}
+
+	var endpoints []endpoint
+
+	for _, f := range funcs {
+		if !f.exported {
+			continue
+		}
+
+		for _, r := range resolve(f, nil, helpers, map[*function]bool{}) {
+			endpoints = append(endpoints, endpoint{Method: r.method, Path: r.path, Func: f.name})
+		}
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		return a.Func < b.Func
+	})
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by genendpoints. DO NOT EDIT.\n\n")
+	buf.WriteString("package stream_chat\n\n")
+	buf.WriteString("var endpoints = []Endpoint{\n")
+	for i, e := range endpoints {
+		if i > 0 && endpoints[i-1] == e {
+			continue
+		}
+		fmt.Fprintf(&buf, "{Method: %q, Path: %q, Func: %q},\n", e.Method, e.Path, e.Func)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// resolve returns the requests made by f and the helpers it calls, args are the literal arguments f is called with
+func resolve(f *function, args []string, helpers map[string]*function, seen map[*function]bool) []request {
+	if seen[f] {
+		return nil
+	}
+	seen[f] = true
+	defer delete(seen, f)
+
+	var reqs []request
+
+	for _, r := range f.requests {
+		if r.pathParam >= 0 {
+			if r.pathParam >= len(args) || args[r.pathParam] == "" {
+				continue
+			}
+			r.path = args[r.pathParam]
+		}
+		reqs = append(reqs, r)
+	}
+
+	for _, c := range f.calls {
+		if h, ok := helpers[c.name]; ok {
+			reqs = append(reqs, resolve(h, c.args, helpers, seen)...)
+		}
+	}
+
+	return reqs
+}
+
+func parseFunc(fd *ast.FuncDecl) *function {
+	f := &function{name: fd.Name.Name, exported: fd.Name.IsExported()}
+
+	if fd.Recv != nil && len(fd.Recv.List) > 0 {
+		recv := fd.Recv.List[0].Type
+		if star, ok := recv.(*ast.StarExpr); ok {
+			recv = star.X
+		}
+		if id, ok := recv.(*ast.Ident); ok {
+			f.name = id.Name + "." + f.name
+			f.exported = f.exported && id.IsExported()
+		}
+	}
+
+	params := map[string]int{}
+	i := 0
+	for _, field := range fd.Type.Params.List {
+		for _, name := range field.Names {
+			params[name.Name] = i
+			i++
+		}
+	}
+
+	paths := map[string][]string{} // local path variables, with every path assigned to them
+
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				if id, ok := n.Lhs[0].(*ast.Ident); ok {
+					if p, ok := pathExpr(n.Rhs[0]); ok {
+						paths[id.Name] = append(paths[id.Name], p)
+					}
+				}
+			}
+		case *ast.CallExpr:
+			name := callName(n)
+			switch {
+			case name == "makeRequest" && len(n.Args) >= 2:
+				sel, ok := n.Args[0].(*ast.SelectorExpr)
+				if !ok || methods[sel.Sel.Name] == "" {
+					return true
+				}
+				method := methods[sel.Sel.Name]
+
+				if id, ok := n.Args[1].(*ast.Ident); ok {
+					if ps, ok := paths[id.Name]; ok {
+						for _, p := range ps {
+							f.requests = append(f.requests, request{method: method, path: p, pathParam: -1})
+						}
+					} else if i, ok := params[id.Name]; ok {
+						f.requests = append(f.requests, request{method: method, pathParam: i})
+					}
+				} else if p, ok := pathExpr(n.Args[1]); ok {
+					f.requests = append(f.requests, request{method: method, path: p, pathParam: -1})
+				}
+			case name != "" && !ast.IsExported(name):
+				c := call{name: name}
+				for _, arg := range n.Args {
+					p, _ := pathExpr(arg)
+					c.args = append(c.args, p)
+				}
+				f.calls = append(f.calls, c)
+			}
+
+			// helpers passed as values, ie c.inBatches(users, c.upsertUsers)
+			for _, arg := range n.Args {
+				if name := exprName(arg); name != "" && !ast.IsExported(name) {
+					f.calls = append(f.calls, call{name: name})
+				}
+			}
+		}
+		return true
+	})
+
+	return f
+}
+
+func callName(c *ast.CallExpr) string {
+	return exprName(c.Fun)
+}
+
+// exprName returns the name of an identifier or the selected name of a selector, ie "upsertUsers" of c.upsertUsers
+func exprName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+// pathExpr returns the path of a string literal, buildPath or Channel.path call,
+// segments which are not literals are named by the expression in braces, ie "users/{targetID}"
+func pathExpr(e ast.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+	case *ast.CallExpr:
+		var segments []string
+		switch callName(e) {
+		case "buildPath":
+		case "path":
+			segments = []string{"channels", "{type}", "{id}"}
+		default:
+			return "", false
+		}
+		for _, arg := range e.Args {
+			segments = append(segments, segment(arg))
+		}
+		return strings.Join(segments, "/"), true
+	}
+	return "", false
+}
+
+func segment(e ast.Expr) string {
+	if p, ok := pathExpr(e); ok {
+		return p
+	}
+
+	switch e := e.(type) {
+	case *ast.Ident:
+		return "{" + e.Name + "}"
+	case *ast.SelectorExpr:
+		// fields are named like the API parameters, ie ch.Type as {type}
+		return "{" + strings.ToLower(e.Sel.Name) + "}"
+	}
+	return "{}"
+}
@@ -0,0 +1,152 @@
+package stream_chat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// JournalEntry is a mutating request which failed transiently
+type JournalEntry struct {
+	ID     string     `json:"id"`
+	Method string     `json:"method"`
+	Path   string     `json:"path"`
+	Params url.Values `json:"params,omitempty"`
+	Body   []byte     `json:"body,omitempty"` // request JSON
+
+	Error     string    `json:"error"` // error of the failed attempt
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RequestJournal stores failed requests for Client.Replay.
+// Entries are JSON serializable, so a journal can be backed by a database or a queue.
+type RequestJournal interface {
+	Append(entry *JournalEntry) error
+	Entries() ([]*JournalEntry, error) // in the order of appending
+	Remove(id string) error
+}
+
+func newJournalEntry(method string, path string, params url.Values, body []byte) *JournalEntry {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	entry := &JournalEntry{
+		ID:        hex.EncodeToString(id),
+		Method:    method,
+		Path:      path,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+
+	if params != nil {
+		entry.Params = make(url.Values, len(params))
+		for k, v := range params {
+			entry.Params[k] = append([]string(nil), v...)
+		}
+	}
+
+	return entry
+}
+
+// transientFailure reports whether a request with the response status may succeed when retried
+func transientFailure(status int) bool {
+	return status == 0 || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// Replay resends journaled requests in order, removing each one which succeeds.
+// Replay stops at the first failing request, which stays in the journal.
+// Returns the number of replayed requests.
+func (c *Client) Replay() (int, error) {
+	if c.Journal == nil {
+		return 0, nil
+	}
+
+	entries, err := c.Journal.Entries()
+	if err != nil {
+		return 0, err
+	}
+
+	for i, entry := range entries {
+		var params url.Values
+		for k, v := range entry.Params {
+			if params == nil {
+				params = make(url.Values, len(entry.Params))
+			}
+			params[k] = append([]string(nil), v...)
+		}
+
+		if c.readOnly && !readOnlyRequest(entry.Method, entry.Path, entry.Body) {
+			return i, ErrReadOnly
+		}
+
+		if _, err := c.send(entry.Method, entry.Path, params, entry.Body, nil); err != nil {
+			return i, fmt.Errorf("replay %s %s: %v", entry.Method, entry.Path, err)
+		}
+
+		if err := c.Journal.Remove(entry.ID); err != nil {
+			return i, err
+		}
+	}
+
+	return len(entries), nil
+}
+
+// MemoryJournal is a RequestJournal kept in memory, entries are lost when the process exits.
+// The zero value is an empty journal ready to use.
+type MemoryJournal struct {
+	// a pointer, as generated JSON methods copy the struct;
+	// journals without one, ie MemoryJournal{}, share journalsMu
+	mu      *sync.Mutex
+	entries []*JournalEntry
+}
+
+// journalsMu guards memory journals which were not created by NewMemoryJournal
+var journalsMu sync.Mutex
+
+func NewMemoryJournal() *MemoryJournal {
+	return &MemoryJournal{mu: &sync.Mutex{}}
+}
+
+func (j *MemoryJournal) locker() *sync.Mutex {
+	if j.mu == nil {
+		return &journalsMu
+	}
+	return j.mu
+}
+
+func (j *MemoryJournal) Append(entry *JournalEntry) error {
+	mu := j.locker()
+	mu.Lock()
+	defer mu.Unlock()
+
+	j.entries = append(j.entries, entry)
+
+	return nil
+}
+
+func (j *MemoryJournal) Entries() ([]*JournalEntry, error) {
+	mu := j.locker()
+	mu.Lock()
+	defer mu.Unlock()
+
+	return append([]*JournalEntry(nil), j.entries...), nil
+}
+
+func (j *MemoryJournal) Remove(id string) error {
+	mu := j.locker()
+	mu.Lock()
+	defer mu.Unlock()
+
+	for i, entry := range j.entries {
+		if entry.ID == id {
+			j.entries = append(j.entries[:i], j.entries[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package stream_chat
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Replay(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	var bodies []string
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		assert.Equal(t, []string{"key"}, r.URL.Query()["api_key"], "api key is sent once")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("{}"))
+	})
+	journal := NewMemoryJournal()
+	c.Journal = journal
+
+	mustError(t, c.DeleteMessage("ring"), "delete message")
+	mustError(t, c.MarkAllRead("frodo"), "mark all read")
+	_, err := c.GetTask("task")
+	mustError(t, err, "get task")
+
+	entries, _ := journal.Entries()
+	if assert.Len(t, entries, 2, "only mutating requests are journaled") {
+		assert.Equal(t, "messages/ring", entries[0].Path)
+		assert.Equal(t, "channels/read", entries[1].Path)
+	}
+
+	n, err := c.Replay()
+	mustError(t, err, "replay during outage")
+	assert.Equal(t, 0, n)
+
+	status = http.StatusOK
+	bodies = nil
+
+	n, err = c.Replay()
+	mustNoError(t, err, "replay")
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"null", `{"user":{"id":"frodo"}}`}, bodies, "request bodies are replayed")
+
+	entries, _ = journal.Entries()
+	assert.Empty(t, entries, "replayed entries are removed")
+}
+
+func TestClient_Journal_PermanentFailure(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	journal := NewMemoryJournal()
+	c.Journal = journal
+
+	mustError(t, c.DeleteMessage("ring"), "delete message")
+
+	entries, _ := journal.Entries()
+	assert.Empty(t, entries, "permanent failures are not journaled")
+}
+
+func TestMemoryJournal_ZeroValue(t *testing.T) {
+	var journal MemoryJournal
+
+	mustNoError(t, journal.Append(&JournalEntry{ID: "1"}), "append")
+
+	entries, err := journal.Entries()
+	mustNoError(t, err, "entries")
+	assert.Len(t, entries, 1)
+
+	mustNoError(t, journal.Remove("1"), "remove")
+}
+
+func TestClient_Replay_ReadOnly(t *testing.T) {
+	var sent int
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	journal := &MemoryJournal{}
+	mustNoError(t, journal.Append(&JournalEntry{ID: "1", Method: http.MethodDelete, Path: "messages/ring"}), "append")
+
+	ro := c.ReadOnly().(*Client)
+	ro.Journal = journal
+
+	n, err := ro.Replay()
+	assert.Equal(t, ErrReadOnly, err, "mutating entries are not replayed")
+	assert.Equal(t, 0, n)
+	assert.Zero(t, sent, "nothing is sent")
+
+	entries, _ := journal.Entries()
+	assert.Len(t, entries, 1, "entry stays in the journal")
+}
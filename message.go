@@ -1,11 +1,14 @@
 package stream_chat
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
-	"path"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type MessageType string
@@ -19,7 +22,8 @@ const (
 )
 
 type Message struct {
-	ID string `json:"id"`
+	ID  string `json:"id"`
+	CID string `json:"cid,omitempty"` // full id of the message's channel, set by the server
 
 	Text string `json:"text"`
 	HTML string `json:"html"`
@@ -39,11 +43,76 @@ type Message struct {
 
 	MentionedUsers []*User `json:"mentioned_users"`
 
+	Pinned     bool       `json:"pinned,omitempty"`
+	PinnedAt   *time.Time `json:"pinned_at,omitempty"`
+	PinnedBy   *User      `json:"pinned_by,omitempty"`
+	PinExpires *time.Time `json:"pin_expires,omitempty"` // nil for pins without expiration
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
 	// any other fields the user wants to attach a message
-	ExtraData map[string]interface{}
+	ExtraData map[string]interface{} `json:"-,extra"`
+}
+
+// NewMessage returns a regular message with the text.
+// Returns an error if the text is blank or longer than the default max message length.
+func NewMessage(text string) (*Message, error) {
+	switch {
+	case strings.TrimSpace(text) == "":
+		return nil, errors.New("message text is empty")
+	case utf8.RuneCountInString(text) > defaultMessageLength:
+		return nil, fmt.Errorf("message text is longer than %d characters", defaultMessageLength)
+	}
+
+	return &Message{Text: text, Type: MessageTypeRegular}, nil
+}
+
+// reservedMessageFields are not sent from ExtraData: fields set by the server which Message doesn't model,
+// so a fetched message can be updated, and the fields of messageRequestMessage
+var reservedMessageFields = map[string]bool{
+	"id":                  true,
+	"cid":                 true,
+	"command":             true,
+	"silent":              true,
+	"shadowed":            true,
+	"i18n":                true,
+	"latest_reactions":    true,
+	"own_reactions":       true,
+	"reaction_counts":     true,
+	"reaction_scores":     true,
+	"reply_count":         true,
+	"deleted_reply_count": true,
+	"thread_participants": true,
+	"quoted_message":      true,
+	"pinned_at":           true,
+	"pinned_by":           true,
+	"created_at":          true,
+	"updated_at":          true,
+	"deleted_at":          true,
+	"text":                true,
+	"attachments":         true,
+	"user":                true,
+	"mentioned_users":     true,
+	"parent_id":           true,
+	"show_in_channel":     true,
+}
+
+// requestExtraData returns the custom fields of extra which can be sent to the API
+func requestExtraData(extra map[string]interface{}) map[string]interface{} {
+	var custom map[string]interface{}
+
+	for k, v := range extra {
+		if reservedMessageFields[k] {
+			continue
+		}
+		if custom == nil {
+			custom = make(map[string]interface{}, len(extra))
+		}
+		custom[k] = v
+	}
+
+	return custom
 }
 
 func (m *Message) toRequest() messageRequest {
@@ -52,12 +121,15 @@ func (m *Message) toRequest() messageRequest {
 	req.Message = messageRequestMessage{
 		Text:          m.Text,
 		Attachments:   m.Attachments,
-		User:          messageRequestUser{ID: m.User.ID},
-		ExtraData:     m.ExtraData,
+		ExtraData:     requestExtraData(m.ExtraData),
 		ParentID:      m.ParentID,
 		ShowInChannel: m.ShowInChannel,
 	}
 
+	if m.User != nil {
+		req.Message.User = &messageRequestUser{ID: m.User.ID}
+	}
+
 	if len(m.MentionedUsers) > 0 {
 		req.Message.MentionedUsers = make([]string, 0, len(m.MentionedUsers))
 		for _, u := range m.MentionedUsers {
@@ -70,12 +142,51 @@ func (m *Message) toRequest() messageRequest {
 
 type messageRequest struct {
 	Message messageRequestMessage `json:"message"`
+
+	SkipPush               bool              `json:"skip_push,omitempty"`
+	SkipEnrichURL          bool              `json:"skip_enrich_url,omitempty"`
+	ForceModeration        bool              `json:"force_moderation,omitempty"`
+	Pending                bool              `json:"pending,omitempty"`
+	PendingMessageMetadata map[string]string `json:"pending_message_metadata,omitempty"`
+}
+
+// SendMessageOption sets an option of SendMessage
+type SendMessageOption func(*messageRequest)
+
+// MessageSkipPush sends the message without push notifications, ie for bot messages
+func MessageSkipPush() SendMessageOption {
+	return func(r *messageRequest) {
+		r.SkipPush = true
+	}
+}
+
+// MessageSkipEnrichURL sends the message without adding previews of the URLs in its text
+func MessageSkipEnrichURL() SendMessageOption {
+	return func(r *messageRequest) {
+		r.SkipEnrichURL = true
+	}
+}
+
+// MessageForceModeration runs moderation on the message even if the sender is exempt from it, ie for admins
+func MessageForceModeration() SendMessageOption {
+	return func(r *messageRequest) {
+		r.ForceModeration = true
+	}
+}
+
+// MessagePending holds the message for moderation, it is only visible to the sender until committed,
+// see QueryPendingMessages. metadata is stored with the pending message, ie {"reason": "review"}, may be nil.
+func MessagePending(metadata map[string]string) SendMessageOption {
+	return func(r *messageRequest) {
+		r.Pending = true
+		r.PendingMessageMetadata = metadata
+	}
 }
 
 type messageRequestMessage struct {
 	Text           string                 `json:"text"`
 	Attachments    []*Attachment          `json:"attachments"`
-	User           messageRequestUser     `json:"user"`
+	User           *messageRequestUser    `json:"user,omitempty"`
 	MentionedUsers []string               `json:"mentioned_users"`
 	ParentID       string                 `json:"parent_id"`
 	ShowInChannel  bool                   `json:"show_in_channel"`
@@ -107,7 +218,8 @@ type Attachment struct {
 }
 
 // SendMessage sends a message to the channel. Returns full message details from server
-func (ch *Channel) SendMessage(message *Message, userID string) (*Message, error) {
+// opts: send options, ie MessageSkipPush()
+func (ch *Channel) SendMessage(message *Message, userID string, opts ...SendMessageOption) (*Message, error) {
 	switch {
 	case message == nil:
 		return nil, errors.New("message is nil")
@@ -119,9 +231,14 @@ func (ch *Channel) SendMessage(message *Message, userID string) (*Message, error
 
 	message.User = &User{ID: userID}
 
-	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "message")
+	req := message.toRequest()
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	p := ch.path("message")
 
-	err := ch.client.makeRequest(http.MethodPost, p, nil, message.toRequest(), &resp)
+	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +246,7 @@ func (ch *Channel) SendMessage(message *Message, userID string) (*Message, error
 	return resp.Message, nil
 }
 
-// MarkAllRead marks all messages as read for userID
+// MarkAllRead marks the messages of all channels as read for userID, ie after an email digest was sent
 func (c *Client) MarkAllRead(userID string) error {
 	if userID == "" {
 		return errors.New("user ID must be not empty")
@@ -144,7 +261,9 @@ func (c *Client) MarkAllRead(userID string) error {
 	return c.makeRequest(http.MethodPost, "channels/read", nil, data, nil)
 }
 
-// UpdateMessage updates message with given msgID
+// UpdateMessage replaces text, attachments and custom fields of the message with given msgID,
+// ie to rewrite offensive text and set {"edited_by_moderator": true} in ExtraData.
+// Fields which are not set are cleared, see PartialUpdateMessage to change single fields.
 func (c *Client) UpdateMessage(msg *Message, msgID string) (*Message, error) {
 	switch {
 	case msg == nil:
@@ -155,7 +274,7 @@ func (c *Client) UpdateMessage(msg *Message, msgID string) (*Message, error) {
 
 	var resp messageResponse
 
-	p := path.Join("messages", url.PathEscape(msgID))
+	p := buildPath("messages", msgID)
 
 	err := c.makeRequest(http.MethodPost, p, nil, msg.toRequest(), &resp)
 	if err != nil {
@@ -165,12 +284,49 @@ func (c *Client) UpdateMessage(msg *Message, msgID string) (*Message, error) {
 	return resp.Message, nil
 }
 
+// PartialMessageUpdate describes changes of a message's fields,
+// fields in Set are overwritten, fields in Unset are removed
+type PartialMessageUpdate struct {
+	Set   map[string]interface{} `json:"set,omitempty"`
+	Unset []string               `json:"unset,omitempty"`
+}
+
+type partialMessageRequest struct {
+	PartialMessageUpdate
+	UserID string `json:"user_id,omitempty"`
+}
+
+// PartialUpdateMessage sets and unsets fields of the message with given msgID, other fields are kept,
+// so concurrent edits of different fields don't overwrite each other, ie {"translated_text": text}.
+// userID: user who updates the message, may be empty for server-side updates
+func (c *Client) PartialUpdateMessage(msgID string, update PartialMessageUpdate, userID string) (*Message, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case len(update.Set) == 0 && len(update.Unset) == 0:
+		return nil, errors.New("set and unset are empty")
+	}
+
+	req := partialMessageRequest{PartialMessageUpdate: update, UserID: userID}
+
+	var resp messageResponse
+
+	p := buildPath("messages", msgID)
+
+	err := c.makeRequest(http.MethodPut, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Message, nil
+}
+
 func (c *Client) DeleteMessage(msgID string) error {
 	if msgID == "" {
 		return errors.New("message ID must be not empty")
 	}
 
-	p := path.Join("messages", url.PathEscape(msgID))
+	p := buildPath("messages", msgID)
 
 	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
 }
@@ -186,7 +342,7 @@ func (ch *Channel) GetReplies(parentID string, options map[string][]string) ([]*
 		return nil, errors.New("parent ID is empty")
 	}
 
-	p := path.Join("messages", url.PathEscape(parentID), "replies")
+	p := buildPath("messages", parentID, "replies")
 
 	var resp repliesResponse
 
@@ -194,3 +350,62 @@ func (ch *Channel) GetReplies(parentID string, options map[string][]string) ([]*
 
 	return resp.Messages, err
 }
+
+type pendingMessagesResponse struct {
+	Messages []*Message `json:"messages"`
+}
+
+// QueryPendingMessages returns messages of the channel which are held for moderation and not yet committed
+// options: Pagination params, ie {"limit":{10}, "id_lt": {last_messageID}}
+func (ch *Channel) QueryPendingMessages(options map[string][]string) ([]*Message, error) {
+	p := ch.path("pending_messages")
+
+	var resp pendingMessagesResponse
+
+	err := ch.client.makeRequest(http.MethodGet, p, options, nil, &resp)
+
+	return resp.Messages, err
+}
+
+type pinnedMessagesRequest struct {
+	Limit          int           `json:"limit,omitempty"`
+	PinnedAtBefore *time.Time    `json:"pinned_at_before,omitempty"`
+	Sort           []*SortOption `json:"sort,omitempty"`
+}
+
+type pinnedMessagesResponse struct {
+	Messages []*Message `json:"messages"`
+}
+
+// GetPinnedMessages returns up to limit pinned messages of the channel, the latest pinned first.
+// before: return messages pinned before, ie PinnedAt of the last message of the previous page; zero for the first page.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
+func (ch *Channel) GetPinnedMessages(limit int, before time.Time, sort ...*SortOption) ([]*Message, error) {
+	if limit < 0 {
+		return nil, errors.New("limit is negative")
+	}
+
+	if len(sort) == 0 {
+		sort = []*SortOption{{Field: "pinned_at", Direction: -1}}
+	}
+
+	req := pinnedMessagesRequest{Limit: limit, Sort: sort}
+	if !before.IsZero() {
+		req.PinnedAtBefore = &before
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"payload": {string(payload)}}
+
+	p := ch.path("pinned_messages")
+
+	var resp pinnedMessagesResponse
+
+	err = ch.client.makeRequest(http.MethodGet, p, params, nil, &resp)
+
+	return resp.Messages, err
+}
@@ -0,0 +1,168 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	tailPageSize     = 100
+	tailPollInterval = 10 * time.Second
+)
+
+// MessageSink receives messages emitted by TailMessages, ie an adapter of a Kafka writer
+type MessageSink interface {
+	WriteMessages(messages ...*Message) error
+}
+
+// MessageSinkFunc is an adapter to use a function as MessageSink
+type MessageSinkFunc func(messages ...*Message) error
+
+func (f MessageSinkFunc) WriteMessages(messages ...*Message) error {
+	return f(messages...)
+}
+
+type TailOptions struct {
+	Filter map[string]interface{} // channels filter, ie {"type": "messaging"}
+	Since  time.Time              // watermark, only messages created at or after it are emitted
+
+	PollInterval time.Duration // pause between polls once caught up, 10 seconds by default
+
+	// Checkpoint is called with the new watermark after every page written to the sink,
+	// persist it to resume tailing from it.
+	Checkpoint func(watermark time.Time) error `json:"-"`
+}
+
+type searchRequest struct {
+	FilterConditions        map[string]interface{} `json:"filter_conditions"`
+	MessageFilterConditions map[string]interface{} `json:"message_filter_conditions"`
+	Sort                    []*SortOption          `json:"sort,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+type searchResult struct {
+	Message *Message `json:"message"`
+}
+
+type searchResponse struct {
+	Results []*searchResult `json:"results"`
+}
+
+// messagesSince returns messages of the matching channels created at or after since, oldest first
+func (c *Client) messagesSince(filter map[string]interface{}, since time.Time, offset int) ([]*Message, error) {
+	req := searchRequest{
+		FilterConditions: filter,
+		MessageFilterConditions: map[string]interface{}{
+			"created_at": map[string]interface{}{"$gte": since.UTC().Format(time.RFC3339Nano)},
+		},
+		Sort:   []*SortOption{{Field: "created_at", Direction: 1}},
+		Limit:  tailPageSize,
+		Offset: offset,
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("payload", string(payload))
+
+	var resp searchResponse
+
+	if err := c.makeRequest(http.MethodGet, "search", params, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		if r.Message != nil {
+			messages = append(messages, r.Message)
+		}
+	}
+
+	return messages, nil
+}
+
+// TailMessages polls messages of the channels matching opts.Filter created since the watermark
+// and writes them to the sink in creation order, until done is closed or an error occurs.
+// Returns the last watermark, which can be passed as opts.Since to resume.
+//
+// Messages are delivered at least once: on resume, messages created exactly at the
+// watermark may be written again.
+func (c *Client) TailMessages(opts TailOptions, sink MessageSink, done <-chan struct{}) (time.Time, error) {
+	switch {
+	case sink == nil:
+		return opts.Since, errors.New("sink is nil")
+	case len(opts.Filter) == 0:
+		return opts.Since, errors.New("filter is empty")
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = tailPollInterval
+	}
+
+	watermark := opts.Since
+	// ids of the messages created at the watermark which were already written
+	seen := map[string]bool{}
+
+	for {
+		for offset := 0; ; {
+			page, err := c.messagesSince(opts.Filter, watermark, offset)
+			if err != nil {
+				return watermark, err
+			}
+
+			batch := make([]*Message, 0, len(page))
+			next := watermark
+			for _, m := range page {
+				if !seen[m.ID] {
+					batch = append(batch, m)
+				}
+				if m.CreatedAt.After(next) {
+					next = m.CreatedAt
+				}
+			}
+
+			if len(batch) > 0 {
+				if err := sink.WriteMessages(batch...); err != nil {
+					return watermark, err
+				}
+			}
+
+			if next.After(watermark) {
+				watermark, seen, offset = next, map[string]bool{}, 0
+			} else {
+				// the whole page was created at the watermark
+				offset += len(page)
+			}
+			for _, m := range page {
+				if m.CreatedAt.Equal(watermark) {
+					seen[m.ID] = true
+				}
+			}
+
+			if opts.Checkpoint != nil && len(batch) > 0 {
+				if err := opts.Checkpoint(watermark); err != nil {
+					return watermark, err
+				}
+			}
+
+			if len(page) < tailPageSize {
+				break
+			}
+		}
+
+		select {
+		case <-done:
+			return watermark, nil
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package stream_chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_TailMessages(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	since := time.Now().Add(-time.Minute)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	var got []*Message
+	done := make(chan struct{})
+
+	sink := MessageSinkFunc(func(messages ...*Message) error {
+		got = append(got, messages...)
+		close(done)
+		return nil
+	})
+
+	watermark, err := c.TailMessages(TailOptions{Filter: map[string]interface{}{"cid": ch.CID}, Since: since}, sink, done)
+	mustNoError(t, err, "tail messages")
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, msg.ID, got[0].ID)
+		assert.Equal(t, ch.CID, got[0].CID)
+	}
+	assert.Equal(t, msg.CreatedAt, watermark, "watermark is moved to the last message")
+}
+
+func TestClient_TailMessages_Validation(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	_, err = c.TailMessages(TailOptions{Filter: map[string]interface{}{"type": "messaging"}}, nil, nil)
+	mustError(t, err, "sink is nil")
+
+	_, err = c.TailMessages(TailOptions{}, MessageSinkFunc(func(...*Message) error { return nil }), nil)
+	mustError(t, err, "filter is empty")
+}
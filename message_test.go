@@ -0,0 +1,140 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMessage(t *testing.T) {
+	m, err := NewMessage("hello")
+	mustNoError(t, err, "new message")
+	assert.Equal(t, "hello", m.Text)
+	assert.Equal(t, MessageTypeRegular, m.Type)
+
+	_, err = NewMessage("  ")
+	mustError(t, err, "blank text")
+
+	_, err = NewMessage(strings.Repeat("ü", defaultMessageLength))
+	mustNoError(t, err, "length is counted in characters")
+
+	_, err = NewMessage(strings.Repeat("a", defaultMessageLength+1))
+	mustError(t, err, "too long text")
+}
+
+func TestChannel_GetPinnedMessages(t *testing.T) {
+	var payload map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/channels/messaging/general/pinned_messages", r.URL.Path)
+		_ = json.Unmarshal([]byte(r.URL.Query().Get("payload")), &payload)
+		_, _ = w.Write([]byte(`{"messages": [{"id": "msg-1", "pinned": true, "pinned_at": "2020-01-02T00:00:00Z"}]}`))
+	})
+
+	ch := c.newChannel("messaging", "general")
+
+	msgs, err := ch.GetPinnedMessages(10, time.Time{})
+	mustNoError(t, err, "get pinned messages")
+	if assert.Len(t, msgs, 1) {
+		assert.True(t, msgs[0].Pinned)
+		assert.NotNil(t, msgs[0].PinnedAt)
+	}
+	assert.NotContains(t, payload, "pinned_at_before", "first page")
+	assert.Equal(t, []interface{}{map[string]interface{}{"field": "pinned_at", "direction": -1.0}}, payload["sort"], "latest pinned first")
+
+	before := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	_, err = ch.GetPinnedMessages(10, before, &SortOption{Field: "pinned_at", Direction: 1})
+	mustNoError(t, err, "get next page")
+	assert.Equal(t, "2020-01-02T00:00:00Z", payload["pinned_at_before"])
+	assert.Equal(t, 1.0, payload["sort"].([]interface{})[0].(map[string]interface{})["direction"])
+}
+
+func TestChannel_SendMessage_options(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"message": {"id": "msg-1", "text": "beep"}}`))
+	})
+
+	ch := c.newChannel("messaging", "general")
+
+	_, err := ch.SendMessage(&Message{Text: "beep"}, "bot")
+	mustNoError(t, err, "send message")
+	assert.NotContains(t, body, "skip_push", "no options by default")
+
+	_, err = ch.SendMessage(&Message{Text: "beep"}, "bot",
+		MessageSkipPush(), MessageSkipEnrichURL(), MessageForceModeration(), MessagePending(map[string]string{"reason": "review"}))
+	mustNoError(t, err, "send message with options")
+
+	assert.Equal(t, true, body["skip_push"])
+	assert.Equal(t, true, body["skip_enrich_url"])
+	assert.Equal(t, true, body["force_moderation"])
+	assert.Equal(t, true, body["pending"])
+	assert.Equal(t, map[string]interface{}{"reason": "review"}, body["pending_message_metadata"])
+	assert.Equal(t, "beep", body["message"].(map[string]interface{})["text"])
+}
+
+func TestClient_UpdateMessage(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/messages/msg-1", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"message": {"id": "msg-1", "text": "***", "edited_by_moderator": true}}`))
+	})
+
+	msg, err := c.UpdateMessage(&Message{
+		Text: "***",
+		ExtraData: map[string]interface{}{
+			"edited_by_moderator": true,
+			// set by the server on a fetched message
+			"reaction_scores": map[string]interface{}{"like": 2},
+			"silent":          false,
+			"i18n":            map[string]interface{}{"language": "en"},
+			"command":         "",
+			"deleted_at":      nil,
+			"text":            "original",
+		},
+	}, "msg-1")
+	mustNoError(t, err, "update message without user")
+
+	sent := body["message"].(map[string]interface{})
+	assert.Equal(t, "***", sent["text"])
+	assert.Equal(t, true, sent["edited_by_moderator"], "custom fields are sent")
+	for _, field := range []string{"reaction_scores", "silent", "i18n", "command", "deleted_at"} {
+		assert.NotContains(t, sent, field, "server fields are not sent")
+	}
+	assert.NotContains(t, sent, "user")
+
+	assert.Equal(t, true, msg.ExtraData["edited_by_moderator"], "custom fields are decoded")
+}
+
+func TestClient_PartialUpdateMessage(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/messages/msg-1", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"message": {"id": "msg-1", "text": "hola", "translated_text": "hello"}}`))
+	})
+
+	msg, err := c.PartialUpdateMessage("msg-1", PartialMessageUpdate{
+		Set:   map[string]interface{}{"translated_text": "hello"},
+		Unset: []string{"verified"},
+	}, "translator")
+	mustNoError(t, err, "partial update message")
+
+	assert.Equal(t, map[string]interface{}{"translated_text": "hello"}, body["set"])
+	assert.Equal(t, []interface{}{"verified"}, body["unset"])
+	assert.Equal(t, "translator", body["user_id"])
+	assert.Equal(t, "hello", msg.ExtraData["translated_text"])
+
+	_, err = c.PartialUpdateMessage("msg-1", PartialMessageUpdate{}, "")
+	mustError(t, err, "nothing to update")
+}
@@ -0,0 +1,61 @@
+package stream_chat
+
+import (
+	"errors"
+	"net/http"
+)
+
+type ModerationAction string
+
+const (
+	ModerationActionKeep   ModerationAction = "keep"   // content passed the checks
+	ModerationActionFlag   ModerationAction = "flag"   // content should be reviewed
+	ModerationActionRemove ModerationAction = "remove" // content should not be published
+)
+
+// ModerationPayload is the content to check
+type ModerationPayload struct {
+	Texts  []string `json:"texts,omitempty"`
+	Images []string `json:"images,omitempty"` // image URLs
+
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+type ModerationCheckRequest struct {
+	EntityType      string             `json:"entity_type"`                 // kind of the content, ie "caption"
+	EntityID        string             `json:"entity_id"`                   // ID of the content in your app
+	EntityCreatorID string             `json:"entity_creator_id,omitempty"` // ID of the user who created the content
+	Payload         *ModerationPayload `json:"moderation_payload"`
+
+	ConfigKey string `json:"config_key,omitempty"` // moderation policy to apply, app default if empty
+}
+
+type ModerationCheckResponse struct {
+	RecommendedAction ModerationAction `json:"recommended_action"` // one of ModerationAction* constants
+	Status            string           `json:"status"`
+	TaskID            string           `json:"task_id,omitempty"` // set when some checks run asynchronously
+}
+
+// CheckModeration submits content to the moderation engine and returns the verdict,
+// so content published outside of chat can be screened with the same policies.
+func (c *Client) CheckModeration(req *ModerationCheckRequest) (*ModerationCheckResponse, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("moderation check request is nil")
+	case req.EntityType == "":
+		return nil, errors.New("entity type is empty")
+	case req.EntityID == "":
+		return nil, errors.New("entity ID is empty")
+	case req.Payload == nil || len(req.Payload.Texts)+len(req.Payload.Images)+len(req.Payload.Custom) == 0:
+		return nil, errors.New("moderation payload is empty")
+	}
+
+	var resp ModerationCheckResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/check", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
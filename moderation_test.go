@@ -0,0 +1,29 @@
+package stream_chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CheckModeration(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.CheckModeration(&ModerationCheckRequest{
+		EntityType:      "caption",
+		EntityID:        randomString(10),
+		EntityCreatorID: serverUser.ID,
+		Payload:         &ModerationPayload{Texts: []string{"a perfectly fine caption"}},
+	})
+	mustNoError(t, err, "check moderation")
+
+	assert.Equal(t, ModerationActionKeep, resp.RecommendedAction)
+}
+
+func TestClient_CheckModeration_Validation(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	_, err = c.CheckModeration(&ModerationCheckRequest{EntityType: "caption", EntityID: "1", Payload: &ModerationPayload{}})
+	mustError(t, err, "payload is empty")
+}
@@ -0,0 +1,98 @@
+package stream_chat
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxUserIDLength    = 255
+	maxChannelIDLength = 64
+)
+
+var (
+	userIDRegexp    = regexp.MustCompile(`^[\w@-]+$`)
+	channelIDRegexp = regexp.MustCompile(`^[\w!-]+$`)
+)
+
+// buildPath joins the given path segments escaping each of them,
+// so IDs with characters like ":", "@" or "/" always stay a single segment.
+func buildPath(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = escapeSegment(s)
+	}
+
+	return strings.Join(escaped, "/")
+}
+
+// escapeSegment percent-encodes every byte except RFC 3986 unreserved characters.
+// Dot segments are encoded as well, so they can't be resolved as relative path references.
+func escapeSegment(s string) string {
+	const hex = "0123456789ABCDEF"
+
+	if s == "." || s == ".." {
+		return strings.Repeat("%2E", len(s))
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&15])
+	}
+
+	return b.String()
+}
+
+func isUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '.', c == '_', c == '~':
+		return true
+	}
+	return false
+}
+
+// path returns the escaped API path of the channel with optional sub-resource segments
+func (ch *Channel) path(segments ...string) string {
+	return buildPath(append([]string{"channels", ch.Type, ch.ID}, segments...)...)
+}
+
+// ValidateUserID checks that user ID is not empty, not too long
+// and contains only letters, digits and "@", "_", "-" characters.
+func ValidateUserID(id string) error {
+	switch {
+	case id == "":
+		return errors.New("user ID is empty")
+	case len(id) > maxUserIDLength:
+		return fmt.Errorf("user ID %q is longer than %d characters", id, maxUserIDLength)
+	case !userIDRegexp.MatchString(id):
+		return fmt.Errorf("user ID %q contains invalid characters, allowed are letters, digits, @, _ and -", id)
+	}
+	return nil
+}
+
+// ValidateChannelID checks that channel ID is not empty, not too long
+// and contains only letters, digits and "!", "_", "-" characters.
+func ValidateChannelID(id string) error {
+	switch {
+	case id == "":
+		return errors.New("channel ID is empty")
+	case len(id) > maxChannelIDLength:
+		return fmt.Errorf("channel ID %q is longer than %d characters", id, maxChannelIDLength)
+	case !channelIDRegexp.MatchString(id):
+		return fmt.Errorf("channel ID %q contains invalid characters, allowed are letters, digits, !, _ and -", id)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package stream_chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []string
+		want     string
+	}{
+		{"plain", []string{"users", "frodo-baggins"}, "users/frodo-baggins"},
+		{"colon", []string{"channels", "messaging", "a:b"}, "channels/messaging/a%3Ab"},
+		{"at sign", []string{"users", "frodo@shire.me", "export"}, "users/frodo%40shire.me/export"},
+		{"slash", []string{"messages", "a/b", "replies"}, "messages/a%2Fb/replies"},
+		{"dots", []string{"users", "..", "export"}, "users/%2E%2E/export"},
+		{"unicode", []string{"users", "ü"}, "users/%C3%BC"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildPath(tt.segments...))
+		})
+	}
+}
+
+func TestChannel_path(t *testing.T) {
+	ch := &Channel{Type: "messaging", ID: "!members-a:b"}
+
+	assert.Equal(t, "channels/messaging/%21members-a%3Ab", ch.path())
+	assert.Equal(t, "channels/messaging/%21members-a%3Ab/event", ch.path("event"))
+}
+
+func TestClient_requestURL_keepsEscaping(t *testing.T) {
+	c := &Client{BaseURL: defaultBaseURL, apiKey: "key"}
+
+	got, err := c.requestURL(buildPath("users", "a:b@c/d"), nil)
+	mustNoError(t, err, "request url")
+
+	assert.Equal(t, defaultBaseURL+"/users/a%3Ab%40c%2Fd?api_key=key", got)
+}
+
+func TestValidateUserID(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{"frodo-baggins", false},
+		{"frodo@shire_me", false},
+		{"", true},
+		{"frodo baggins", true},
+		{"frodo:baggins", true},
+		{string(make([]byte, maxUserIDLength+1)), true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateUserID(tt.id)
+		if tt.wantErr {
+			assert.Error(t, err, tt.id)
+		} else {
+			assert.NoError(t, err, tt.id)
+		}
+	}
+}
+
+func TestValidateChannelID(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{"fellowship-of-the-ring", false},
+		{"!members-abc_1", false},
+		{"", true},
+		{"a:b", true},
+		{"frodo@shire", true},
+		{randomString(maxChannelIDLength + 1), true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateChannelID(tt.id)
+		if tt.wantErr {
+			assert.Error(t, err, tt.id)
+		} else {
+			assert.NoError(t, err, tt.id)
+		}
+	}
+}
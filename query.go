@@ -0,0 +1,298 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type QueryOption struct {
+	// https://getstream.io/chat/docs/#query_syntax
+	Filter map[string]interface{} `json:"filter_conditions,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`  // pagination option: limit number of results
+	Offset int `json:"offset,omitempty"` // pagination option: offset to return items from
+
+	// return users' presence state (online, last_active) in results
+	Presence bool `json:"presence,omitempty"`
+
+	// channel query options, ignored by other queries
+	UserID       string `json:"user_id,omitempty"`       // query on behalf of the user, ie to get their read state
+	MessageLimit *int   `json:"message_limit,omitempty"` // number of latest messages per channel, server default if nil
+	MemberLimit  *int   `json:"member_limit,omitempty"`  // number of members per channel, server default if nil
+	WatcherLimit *int   `json:"watcher_limit,omitempty"` // number of watchers per channel, server default if nil, see Channel.QueryWatchers
+}
+
+// MinimalChannelQuery returns channel query options which skip messages, members and watchers,
+// so only channel data is returned without the channel state. Use it for channel lists,
+// it's an order of magnitude less data.
+func MinimalChannelQuery(filter map[string]interface{}) *QueryOption {
+	var zero int
+
+	return &QueryOption{
+		Filter:       filter,
+		MessageLimit: &zero,
+		MemberLimit:  &zero,
+		WatcherLimit: &zero,
+	}
+}
+
+type SortOption struct {
+	Field     string `json:"field"`     // field name to sort by, from json tags (in snake case), for example created_at
+	Direction int    `json:"direction"` // [-1, 1]
+}
+
+type queryChannelsRequest struct {
+	Watch    bool `json:"watch"`
+	State    bool `json:"state"`
+	Presence bool `json:"presence"`
+
+	FilterConditions map[string]interface{} `json:"filter_conditions,omitempty"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+
+	UserID       string `json:"user_id,omitempty"`
+	MessageLimit *int   `json:"message_limit,omitempty"`
+	MemberLimit  *int   `json:"member_limit,omitempty"`
+	WatcherLimit *int   `json:"watcher_limit,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+// isZeroLimit reports whether limit is set to zero, nil means the server default
+func isZeroLimit(limit *int) bool {
+	return limit != nil && *limit == 0
+}
+
+type queryChannelsResponse struct {
+	Channels []queryResponse `json:"channels"`
+}
+
+// QueryChannels returns list of channels with members and messages, that match QueryOption.
+// Filter supports the full query syntax, ie {"members": {"$in": []string{userID}}}, {"cid": {"$eq": cid}}
+// or {"last_message_at": {"$gte": since.Format(time.RFC3339)}}.
+// Set MessageLimit, MemberLimit and WatcherLimit to control how much of the channel state is returned,
+// when all of them are zero the state is not requested at all, see MinimalChannelQuery.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
+func (c *Client) QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	req := queryChannelsRequest{
+		State:            !isZeroLimit(q.MessageLimit) || !isZeroLimit(q.MemberLimit) || !isZeroLimit(q.WatcherLimit),
+		Presence:         q.Presence,
+		FilterConditions: q.Filter,
+		Sort:             sort,
+		UserID:           q.UserID,
+		MessageLimit:     q.MessageLimit,
+		MemberLimit:      q.MemberLimit,
+		WatcherLimit:     q.WatcherLimit,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	var resp queryChannelsResponse
+
+	err := c.makeRequest(http.MethodPost, "channels", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Channel, 0, len(resp.Channels))
+	for _, data := range resp.Channels {
+		ch := c.newChannel("", "")
+		if err := data.updateChannel(ch); err != nil {
+			return nil, err
+		}
+		result = append(result, ch)
+	}
+
+	return result, nil
+}
+
+type queryUsersRequest struct {
+	Presence bool `json:"presence"`
+
+	FilterConditions map[string]interface{} `json:"filter_conditions,omitempty"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+type queryUsersResponse struct {
+	Users []*User `json:"users"`
+}
+
+// QueryUsers returns list of users that match QueryOption, ie {"teams": {"$in": ["red"]}} for members of a team.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
+func (c *Client) QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	req := queryUsersRequest{
+		Presence:         q.Presence,
+		FilterConditions: q.Filter,
+		Sort:             sort,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"payload": {string(payload)}}
+
+	var resp queryUsersResponse
+
+	err = c.makeRequest(http.MethodGet, "users", params, nil, &resp)
+
+	return resp.Users, err
+}
+
+type inviteStatus string
+
+// Invite statuses of channel members, see QueryInvites
+const (
+	InviteStatusPending  inviteStatus = "pending"
+	InviteStatusAccepted inviteStatus = "accepted"
+	InviteStatusRejected inviteStatus = "rejected"
+)
+
+// QueryInvites returns the channels the user with given ID was invited to with the invite in given status,
+// ie InviteStatusPending for an invitations inbox.
+// q sets additional filter conditions and pagination, may be nil.
+func (c *Client) QueryInvites(userID string, status inviteStatus, q *QueryOption, sort ...*SortOption) ([]*Channel, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case status != InviteStatusPending && status != InviteStatusAccepted && status != InviteStatusRejected:
+		return nil, fmt.Errorf("invalid invite status %q", status)
+	}
+
+	query := QueryOption{}
+	if q != nil {
+		query = *q
+	}
+
+	query.Filter = make(map[string]interface{}, len(query.Filter)+1)
+	if q != nil {
+		for k, v := range q.Filter {
+			query.Filter[k] = v
+		}
+	}
+	query.Filter["invite"] = string(status)
+	query.UserID = userID
+
+	return c.QueryChannels(&query, sort...)
+}
+
+type queryMembersRequest struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+
+	FilterConditions map[string]interface{} `json:"filter_conditions"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+type queryMembersResponse struct {
+	Members []*ChannelMember `json:"members"`
+}
+
+// QueryMembers returns the channel members that match QueryOption, so large channels can be paged through.
+// Filter ie {"name": {"$autocomplete": "fro"}} for name autocomplete or {"banned": true} for banned members,
+// nil filter matches all members.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options,
+// ie {Field: "created_at", Direction: 1} for join date.
+func (ch *Channel) QueryMembers(q *QueryOption, sort ...*SortOption) ([]*ChannelMember, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	filter := q.Filter
+	if filter == nil {
+		filter = map[string]interface{}{}
+	}
+
+	req := queryMembersRequest{
+		Type:             ch.Type,
+		ID:               ch.ID,
+		FilterConditions: filter,
+		Sort:             sort,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"payload": {string(payload)}}
+
+	var resp queryMembersResponse
+
+	err = ch.client.makeRequest(http.MethodGet, "members", params, nil, &resp)
+
+	return resp.Members, err
+}
+
+const (
+	countChannelsPageSize = 30
+	countUsersPageSize    = 100
+)
+
+// CountChannels returns number of channels that match the filter.
+// The API doesn't return totals, so channels are paged through without their state,
+// which takes one request per 30 channels.
+func (c *Client) CountChannels(filter map[string]interface{}) (int, error) {
+	var count int
+
+	for {
+		req := queryChannelsRequest{
+			FilterConditions: filter,
+			Limit:            countChannelsPageSize,
+			Offset:           count,
+		}
+
+		var resp queryChannelsResponse
+
+		if err := c.makeRequest(http.MethodPost, "channels", nil, req, &resp); err != nil {
+			return 0, err
+		}
+
+		count += len(resp.Channels)
+
+		if len(resp.Channels) < countChannelsPageSize {
+			return count, nil
+		}
+	}
+}
+
+// CountUsers returns number of users that match the filter.
+// The API doesn't return totals, so users are paged through, which takes one request per 100 users.
+func (c *Client) CountUsers(filter map[string]interface{}) (int, error) {
+	var count int
+
+	for {
+		users, err := c.QueryUsers(&QueryOption{Filter: filter, Limit: countUsersPageSize, Offset: count})
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(users)
+
+		if len(users) < countUsersPageSize {
+			return count, nil
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_QueryChannels(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	got, err := c.QueryChannels(&QueryOption{Filter: map[string]interface{}{
+		"id": map[string]interface{}{"$eq": ch.ID},
+	}})
+	mustNoError(t, err, "query channels")
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, ch.CID, got[0].CID, "channel cid")
+		assert.Equal(t, c, got[0].client, "client link")
+	}
+}
+
+func TestClient_QueryChannels_limits(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	for i := 0; i < 3; i++ {
+		_, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+		mustNoError(t, err, "send message")
+	}
+
+	messageLimit, memberLimit := 2, 1
+
+	got, err := c.QueryChannels(&QueryOption{
+		Filter: map[string]interface{}{
+			"cid":     map[string]interface{}{"$eq": ch.CID},
+			"members": map[string]interface{}{"$in": []string{serverUser.ID}},
+		},
+		UserID:       serverUser.ID,
+		MessageLimit: &messageLimit,
+		MemberLimit:  &memberLimit,
+		Limit:        1,
+	}, &SortOption{Field: "last_message_at", Direction: -1})
+	mustNoError(t, err, "query channels")
+
+	if assert.Len(t, got, 1) {
+		assert.Len(t, got[0].Messages, messageLimit)
+		assert.Len(t, got[0].Members, memberLimit)
+	}
+}
+
+func TestClient_QueryChannels_minimal(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	_, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	got, err := c.QueryChannels(MinimalChannelQuery(map[string]interface{}{"cid": ch.CID}))
+	mustNoError(t, err, "query channels")
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, ch.CID, got[0].CID)
+		assert.Empty(t, got[0].Messages)
+		assert.Empty(t, got[0].Members)
+	}
+}
+
+func TestMinimalChannelQuery(t *testing.T) {
+	var body map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = nil
+		_ = json.Unmarshal(data, &body)
+		_, _ = w.Write([]byte(`{"channels":[{"channel":{"type":"messaging","id":"general","cid":"messaging:general"}}]}`))
+	})
+
+	got, err := c.QueryChannels(MinimalChannelQuery(map[string]interface{}{"type": "messaging"}))
+	mustNoError(t, err, "query channels")
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "messaging:general", got[0].CID)
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"watch":             false,
+		"state":             false,
+		"presence":          false,
+		"filter_conditions": map[string]interface{}{"type": "messaging"},
+		"message_limit":     float64(0),
+		"member_limit":      float64(0),
+		"watcher_limit":     float64(0),
+	}, body, "state is skipped and zero limits are sent")
+
+	_, err = c.QueryChannels(&QueryOption{Filter: map[string]interface{}{"type": "messaging"}})
+	mustNoError(t, err, "query channels")
+
+	assert.Equal(t, true, body["state"], "state is requested by default")
+}
+
+func TestClient_QueryUsers(t *testing.T) {
+	c := initClient(t)
+
+	team := randomString(8)
+	users := []*User{
+		{ID: randomString(12), Teams: []string{team}},
+		{ID: randomString(12), Teams: []string{team}},
+	}
+	_, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+
+	got, err := c.QueryUsers(&QueryOption{Filter: map[string]interface{}{
+		"teams": map[string]interface{}{"$in": []string{team}},
+	}}, &SortOption{Field: "created_at", Direction: 1})
+	mustNoError(t, err, "query users")
+
+	if assert.Len(t, got, len(users)) {
+		assert.Equal(t, users[0].ID, got[0].ID)
+		assert.Equal(t, []string{team}, got[0].Teams)
+	}
+}
+
+func TestClient_QueryChannels_team(t *testing.T) {
+	c := initClient(t)
+
+	team := randomString(8)
+	ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, map[string]interface{}{"team": team})
+	mustNoError(t, err, "create channel")
+	defer ch.Delete(false)
+
+	assert.Equal(t, team, ch.Team)
+
+	got, err := c.QueryChannels(&QueryOption{Filter: map[string]interface{}{"team": team}})
+	mustNoError(t, err, "query channels")
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, ch.CID, got[0].CID)
+	}
+}
+
+func TestClient_QueryUsers_presence(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+	_, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert users")
+
+	got, err := c.QueryUsers(&QueryOption{
+		Filter:   map[string]interface{}{"id": map[string]interface{}{"$eq": user.ID}},
+		Presence: true,
+	})
+	mustNoError(t, err, "query users")
+
+	if assert.Len(t, got, 1) {
+		assert.False(t, got[0].Online, "server side user is offline")
+	}
+
+	got, err = c.QueryUsers(&QueryOption{
+		Filter: map[string]interface{}{"online": true, "id": map[string]interface{}{"$eq": user.ID}},
+	})
+	mustNoError(t, err, "query online users")
+
+	assert.Empty(t, got, "no online users")
+}
+
+func TestClient_CountChannels(t *testing.T) {
+	c := initClient(t)
+
+	team := randomString(8)
+	for i := 0; i < 2; i++ {
+		ch, err := c.CreateChannel("messaging", randomString(12), serverUser.ID, map[string]interface{}{"team": team})
+		mustNoError(t, err, "create channel")
+		defer ch.Delete(false)
+	}
+
+	count, err := c.CountChannels(map[string]interface{}{"team": team})
+	mustNoError(t, err, "count channels")
+
+	assert.Equal(t, 2, count)
+}
+
+func TestClient_CountUsers(t *testing.T) {
+	c := initClient(t)
+
+	team := randomString(8)
+	_, err := c.UpsertUsers(&User{ID: randomString(12), Teams: []string{team}}, &User{ID: randomString(12), Teams: []string{team}})
+	mustNoError(t, err, "upsert users")
+
+	count, err := c.CountUsers(map[string]interface{}{"teams": map[string]interface{}{"$in": []string{team}}})
+	mustNoError(t, err, "count users")
+
+	assert.Equal(t, 2, count)
+}
+
+func TestChannel_QueryMembers(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	prefix := randomString(8)
+	users := make([]*User, 3)
+	ids := make([]string, len(users))
+	for i := range users {
+		users[i] = &User{ID: randomString(12), Name: prefix + randomString(4)}
+		ids[i] = users[i].ID
+	}
+
+	_, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+	mustNoError(t, ch.AddMembers(ids, nil), "add members")
+
+	members, err := ch.QueryMembers(&QueryOption{
+		Filter: map[string]interface{}{"name": map[string]interface{}{"$autocomplete": prefix}},
+		Limit:  2,
+	}, &SortOption{Field: "created_at", Direction: 1})
+	mustNoError(t, err, "query members")
+	assert.Len(t, members, 2, "limit is applied")
+
+	members, err = ch.QueryMembers(&QueryOption{
+		Filter: map[string]interface{}{"name": map[string]interface{}{"$autocomplete": prefix}},
+		Limit:  2,
+		Offset: 2,
+	}, &SortOption{Field: "created_at", Direction: 1})
+	mustNoError(t, err, "query members next page")
+	assert.Len(t, members, 1, "offset is applied")
+}
+
+func TestClient_QueryInvites(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+	mustNoError(t, ch.InviteMembers(user.ID), "invite members")
+
+	channels, err := c.QueryInvites(user.ID, InviteStatusPending, &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID},
+	})
+	mustNoError(t, err, "query pending invites")
+	if assert.Len(t, channels, 1) {
+		assert.Equal(t, ch.CID, channels[0].CID)
+	}
+
+	mustNoError(t, ch.AcceptInvite(user.ID, nil), "accept invite")
+
+	channels, err = c.QueryInvites(user.ID, InviteStatusPending, &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID},
+	})
+	mustNoError(t, err, "query pending invites")
+	assert.Empty(t, channels, "invite is not pending")
+
+	channels, err = c.QueryInvites(user.ID, InviteStatusAccepted, nil)
+	mustNoError(t, err, "query accepted invites")
+	assert.NotEmpty(t, channels)
+}
+
+func TestClient_QueryInvites_status(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	_, err = c.QueryInvites("frodo", "maybe", nil)
+	mustError(t, err, "invalid status")
+
+	_, err = c.QueryInvites("", InviteStatusPending, nil)
+	mustError(t, err, "empty user ID")
+}
+
+func TestChannel_QueryWatchers(t *testing.T) {
+	var payload map[string]interface{}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/channels/livestream/main/query", r.URL.Path)
+		data, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &payload)
+		_, _ = w.Write([]byte(`{"watchers": [{"id": "frodo"}, {"id": "sam"}], "watcher_count": 42}`))
+	})
+
+	ch := c.newChannel("livestream", "main")
+
+	watchers, count, err := ch.QueryWatchers(2, 10)
+	mustNoError(t, err, "query watchers")
+
+	assert.Equal(t, 42, count)
+	if assert.Len(t, watchers, 2) {
+		assert.Equal(t, "frodo", watchers[0].ID)
+	}
+	assert.Equal(t, map[string]interface{}{"limit": 2.0, "offset": 10.0}, payload["watchers"])
+
+	_, _, err = ch.QueryWatchers(-1, 0)
+	mustError(t, err, "negative limit")
+}
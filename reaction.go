@@ -3,8 +3,8 @@ package stream_chat
 import (
 	"errors"
 	"net/http"
-	"net/url"
-	"path"
+	"strconv"
+	"sync/atomic"
 )
 
 type Reaction struct {
@@ -40,7 +40,7 @@ func (ch *Channel) SendReaction(reaction *Reaction, messageID string, userID str
 
 	reaction.UserID = userID
 
-	p := path.Join("messages", url.PathEscape(messageID), "reaction")
+	p := buildPath("messages", messageID, "reaction")
 
 	req := reactionRequest{Reaction: reaction}
 	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
@@ -59,7 +59,7 @@ func (ch *Channel) DeleteReaction(messageID string, reactionType string, userID
 		return nil, errors.New("user ID is empty")
 	}
 
-	p := path.Join("messages", url.PathEscape(messageID), "reaction", url.PathEscape(reactionType))
+	p := buildPath("messages", messageID, "reaction", reactionType)
 
 	params := map[string][]string{
 		"user_id": {userID},
@@ -89,7 +89,7 @@ func (ch *Channel) GetReactions(messageID string, options map[string][]string) (
 		return nil, errors.New("message ID is empty")
 	}
 
-	p := path.Join("messages", url.PathEscape(messageID), "reactions")
+	p := buildPath("messages", messageID, "reactions")
 
 	var resp reactionsResponse
 
@@ -97,3 +97,156 @@ func (ch *Channel) GetReactions(messageID string, options map[string][]string) (
 
 	return resp.Reactions, err
 }
+
+const reactionSweepPageSize = 100
+
+// channelMessages returns a page of channel messages older than message with given ID,
+// or the latest messages if beforeID is empty
+func (ch *Channel) channelMessages(beforeID string, limit int) ([]*Message, error) {
+	messages := map[string]interface{}{"limit": limit}
+	if beforeID != "" {
+		messages["id_lt"] = beforeID
+	}
+
+	payload := map[string]interface{}{
+		"state":    true,
+		"messages": messages,
+	}
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, ch.path("query"), nil, payload, &resp)
+
+	return resp.Messages, err
+}
+
+// RemoveReactionsOfType deletes all reactions of given type from all messages of the channel,
+// thread replies included, deletions are throttled by client's BulkThrottle.
+// Returns number of removed reactions.
+func (ch *Channel) RemoveReactionsOfType(reactionType string) (int, error) {
+	if reactionType == "" {
+		return 0, errors.New("reaction type is empty")
+	}
+
+	var removed int
+	var beforeID string
+
+	for {
+		messages, err := ch.channelMessages(beforeID, reactionSweepPageSize)
+		if err != nil {
+			return removed, err
+		}
+
+		n, err := ch.sweepReactionsOfType(messages, reactionType)
+		removed += n
+		if err != nil {
+			return removed, err
+		}
+
+		if len(messages) < reactionSweepPageSize {
+			return removed, nil
+		}
+
+		// messages are ordered from oldest to newest
+		beforeID = messages[0].ID
+	}
+}
+
+// sweepReactionsOfType deletes reactions of given type from the messages and from replies in their threads
+func (ch *Channel) sweepReactionsOfType(messages []*Message, reactionType string) (int, error) {
+	var removed int
+
+	for _, msg := range messages {
+		if msg.ReactionCounts[reactionType] > 0 {
+			n, err := ch.removeMessageReactionsOfType(msg.ID, reactionType)
+			removed += n
+			if err != nil {
+				return removed, err
+			}
+		}
+
+		if msg.ReplyCount > 0 {
+			n, err := ch.removeReplyReactionsOfType(msg.ID, reactionType)
+			removed += n
+			if err != nil {
+				return removed, err
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+// removeReplyReactionsOfType deletes reactions of given type from all replies to the parent message
+func (ch *Channel) removeReplyReactionsOfType(parentID string, reactionType string) (int, error) {
+	var removed int
+	var beforeID string
+
+	for {
+		options := map[string][]string{"limit": {strconv.Itoa(reactionSweepPageSize)}}
+		if beforeID != "" {
+			options["id_lt"] = []string{beforeID}
+		}
+
+		replies, err := ch.GetReplies(parentID, options)
+		if err != nil {
+			return removed, err
+		}
+
+		// replies have no threads of their own
+		for _, msg := range replies {
+			if msg.ReactionCounts[reactionType] == 0 {
+				continue
+			}
+
+			n, err := ch.removeMessageReactionsOfType(msg.ID, reactionType)
+			removed += n
+			if err != nil {
+				return removed, err
+			}
+		}
+
+		if len(replies) < reactionSweepPageSize {
+			return removed, nil
+		}
+
+		// replies are ordered from oldest to newest
+		beforeID = replies[0].ID
+	}
+}
+
+func (ch *Channel) removeMessageReactionsOfType(messageID string, reactionType string) (int, error) {
+	var matched []*Reaction
+
+	for offset := 0; ; offset += reactionSweepPageSize {
+		reactions, err := ch.GetReactions(messageID, map[string][]string{
+			"limit":  {strconv.Itoa(reactionSweepPageSize)},
+			"offset": {strconv.Itoa(offset)},
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, r := range reactions {
+			if r.Type == reactionType {
+				matched = append(matched, r)
+			}
+		}
+
+		if len(reactions) < reactionSweepPageSize {
+			break
+		}
+	}
+
+	var removed int32
+
+	err := ch.client.BulkThrottle.run(len(matched), func(i int) error {
+		if _, err := ch.DeleteReaction(messageID, reactionType, matched[i].UserID); err != nil {
+			return err
+		}
+		atomic.AddInt32(&removed, 1)
+		return nil
+	})
+
+	return int(removed), err
+}
@@ -1,6 +1,7 @@
 package stream_chat
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,7 +10,7 @@ import (
 func TestChannel_SendReaction(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 	msg := &Message{
@@ -43,7 +44,7 @@ func reactionExistsCondition(reactions []*Reaction, searchType string) func() bo
 func TestChannel_DeleteReaction(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 	msg := &Message{
@@ -68,7 +69,7 @@ func TestChannel_DeleteReaction(t *testing.T) {
 func TestChannel_GetReactions(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
-	defer ch.Delete()
+	defer ch.Delete(false)
 
 	user := randomUser()
 	msg := &Message{
@@ -92,3 +93,57 @@ func TestChannel_GetReactions(t *testing.T) {
 
 	assert.Condition(t, reactionExistsCondition(reactions, reaction.Type), "reaction exists")
 }
+
+func TestChannel_RemoveReactionsOfType(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	for _, user := range testUsers {
+		msg, err := ch.SendMessage(&Message{Text: "test message"}, user.ID)
+		mustNoError(t, err, "send message")
+
+		_, err = ch.SendReaction(&Reaction{Type: "love"}, msg.ID, user.ID)
+		mustNoError(t, err, "send reaction")
+
+		_, err = ch.SendReaction(&Reaction{Type: "like"}, msg.ID, serverUser.ID)
+		mustNoError(t, err, "send reaction")
+	}
+
+	removed, err := ch.RemoveReactionsOfType("love")
+	mustNoError(t, err, "remove reactions of type")
+	assert.Equal(t, len(testUsers), removed, "removed reactions count")
+
+	mustNoError(t, ch.refresh(), "refresh channel")
+
+	for _, msg := range ch.Messages {
+		assert.Zero(t, msg.ReactionCounts["love"], "love reactions are removed")
+		assert.NotZero(t, msg.ReactionCounts["like"], "like reactions are kept")
+	}
+}
+
+func TestChannel_RemoveReactionsOfType_replies(t *testing.T) {
+	var deleted []string
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /channels/messaging/general/query":
+			_, _ = w.Write([]byte(`{"messages":[{"id":"msg-1","reply_count":1}]}`))
+		case "GET /messages/msg-1/replies":
+			_, _ = w.Write([]byte(`{"messages":[{"id":"reply-1","parent_id":"msg-1","reaction_counts":{"love":1}}]}`))
+		case "GET /messages/reply-1/reactions":
+			_, _ = w.Write([]byte(`{"reactions":[{"message_id":"reply-1","user_id":"frodo","type":"love"}]}`))
+		case "DELETE /messages/reply-1/reaction/love":
+			deleted = append(deleted, r.URL.Query().Get("user_id"))
+			_, _ = w.Write([]byte(`{"message":{"id":"reply-1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	removed, err := c.newChannel("messaging", "general").RemoveReactionsOfType("love")
+	mustNoError(t, err, "remove reactions of type")
+
+	assert.Equal(t, 1, removed, "reactions on replies are removed")
+	assert.Equal(t, []string{"frodo"}, deleted)
+}
@@ -0,0 +1,100 @@
+package stream_chat
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrReadOnly is returned for mutating requests made by a read-only client
+var ErrReadOnly = errors.New("client is read-only")
+
+// ReadOnlyClient is the subset of StreamClient which doesn't modify app data
+type ReadOnlyClient interface {
+	CountChannels(filter map[string]interface{}) (int, error)
+	CountUsers(filter map[string]interface{}) (int, error)
+	ExportUser(targetID string, options map[string][]string) (*ExportUserResponse, error)
+	GetAppSettings() (*AppSettings, error)
+	GetBlockedUsers(userID string) ([]*BlockedUser, error)
+	GetBlocklist(name string) (*Blocklist, error)
+	GetChannelType(chanType string) (ct *ChannelType, err error)
+	GetDevices(userId string) (devices []*Device, err error)
+	GetDevicesForUsers(userIDs ...string) (map[string][]*Device, error)
+	GetExportChannelsStatus(taskID string) (*Task, error)
+	GetTask(taskID string) (*Task, error)
+	ListBlocklists() ([]*Blocklist, error)
+	ListChannelTypes() (map[string]*ChannelType, error)
+	QueryBannedUsers(q *QueryOption, sort ...*SortOption) ([]*Ban, error)
+	QueryChannelMutes(userID string) ([]*ChannelMute, error)
+	QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel, error)
+	QueryInvites(userID string, status inviteStatus, q *QueryOption, sort ...*SortOption) ([]*Channel, error)
+	QueryMessageFlags(q *QueryOption) ([]*MessageFlag, error)
+	QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error)
+	SharedChannels(userA string, userB string, chanType string) ([]*Channel, error)
+	VerifyWebhook(body []byte, signature []byte) bool
+	WaitForTask(taskID string, pollInterval time.Duration, timeout time.Duration) (*Task, error)
+}
+
+var _ ReadOnlyClient = (*Client)(nil)
+
+// ReadOnly returns a copy of the client which only exposes ReadOnlyClient methods.
+// Requests which could modify app data fail with ErrReadOnly even when the client is
+// converted back to *Client, channels returned by it are read-only as well.
+func (c *Client) ReadOnly() ReadOnlyClient {
+	ro := *c
+	ro.readOnly = true
+
+	return &ro
+}
+
+// readOnlyRequest reports whether the request doesn't modify app data.
+// Channel queries are reads unless their body creates the channel, see createPayload.
+func readOnlyRequest(method string, path string, body []byte) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		if path == "channels" {
+			return true
+		}
+
+		// single channel state query; channels/{type}/query without ID creates a distinct channel
+		segments := strings.Split(path, "/")
+		if len(segments) == 4 && segments[0] == "channels" && segments[3] == "query" {
+			return !createPayload(body)
+		}
+	}
+
+	return false
+}
+
+// createPayload reports whether the channel query body would create the channel
+// or change its data, ie with "data", "members" or "created_by"
+func createPayload(body []byte) bool {
+	if len(body) == 0 {
+		return false
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return true
+	}
+
+	if _, ok := payload["members"]; ok {
+		return true
+	}
+	if _, ok := payload["created_by"]; ok {
+		return true
+	}
+
+	if raw, ok := payload["data"]; ok {
+		var data map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &data); err != nil || len(data) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package stream_chat
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ReadOnly(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	ro := c.ReadOnly().(*Client)
+
+	assert.Equal(t, ErrReadOnly, ro.DeleteUser("gandalf", nil), "mutating request is refused")
+	assert.Equal(t, ErrReadOnly, ro.UnblockUser("gandalf", "saruman"), "mutating request is refused")
+
+	ch := &Channel{Type: "messaging", ID: "fellowship", client: ro}
+	assert.Equal(t, ErrReadOnly, ch.Delete(false), "channel of read-only client is read-only")
+
+	assert.False(t, c.readOnly, "original client is not affected")
+}
+
+func TestClient_ReadOnly_CreateChannel(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	ro := c.ReadOnly().(*Client)
+
+	_, err = ro.CreateChannel("messaging", "fellowship", "frodo", nil)
+	assert.Equal(t, ErrReadOnly, err, "get or create is refused")
+
+	_, err = ro.CreateChannel("messaging", "", "frodo", map[string]interface{}{"members": []string{"frodo", "sam"}})
+	assert.Equal(t, ErrReadOnly, err, "distinct channel is refused")
+
+	results := ro.EnsureChannels([]*ChannelInput{{Type: "messaging", ID: "fellowship", CreatedByID: "frodo"}})
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, ErrReadOnly, results[0].Err, "ensure channels is refused")
+	}
+}
+
+func TestReadOnlyRequest(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		want   bool
+	}{
+		{http.MethodGet, "users", "", true},
+		{http.MethodPost, "channels", `{"filter_conditions":{}}`, true},
+		{http.MethodPost, "channels/messaging/fellowship/query", `{"state":true,"data":{}}`, true},
+		{http.MethodPost, "channels/messaging/fellowship/query", `{"state":true,"data":{"created_by":{"id":"frodo"}}}`, false},
+		{http.MethodPost, "channels/messaging/fellowship/query", `{"state":true,"members":["frodo"]}`, false},
+		{http.MethodPost, "channels/messaging/query", `{"state":true}`, false},
+		{http.MethodPost, "channels/messaging/fellowship/message", "", false},
+		{http.MethodPost, "users", "", false},
+		{http.MethodPatch, "users", "", false},
+		{http.MethodDelete, "channels/messaging/fellowship", "", false},
+	}
+
+	for _, test := range tests {
+		got := readOnlyRequest(test.method, test.path, []byte(test.body))
+		assert.Equal(t, test.want, got, "%s %s %s", test.method, test.path, test.body)
+	}
+}
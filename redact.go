@@ -0,0 +1,62 @@
+package stream_chat
+
+import (
+	"encoding/json"
+)
+
+// Redactor strips sensitive data, ie message text or custom PII fields, from API response
+// bodies before they are included in error messages
+type Redactor interface {
+	Redact(body []byte) []byte
+}
+
+// RedactorFunc is an adapter to use a function as Redactor
+type RedactorFunc func(body []byte) []byte
+
+func (f RedactorFunc) Redact(body []byte) []byte {
+	return f(body)
+}
+
+const redacted = "[redacted]"
+
+// RedactFields returns a Redactor replacing values of the given fields at any depth of
+// JSON bodies, ie RedactFields("text", "email"). Bodies which aren't JSON are kept as is.
+func RedactFields(fields ...string) Redactor {
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, val := range v {
+				if set[k] {
+					v[k] = redacted
+				} else {
+					v[k] = walk(val)
+				}
+			}
+		case []interface{}:
+			for i := range v {
+				v[i] = walk(v[i])
+			}
+		}
+		return v
+	}
+
+	return RedactorFunc(func(body []byte) []byte {
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return body
+		}
+
+		result, err := json.Marshal(walk(v))
+		if err != nil {
+			return body
+		}
+
+		return result
+	})
+}
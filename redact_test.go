@@ -0,0 +1,26 @@
+package stream_chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactFields(t *testing.T) {
+	r := RedactFields("text")
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"nested", `{"messages":[{"id":"1","text":"secret"}]}`, `{"messages":[{"id":"1","text":"[redacted]"}]}`},
+		{"object value", `{"text":{"a":1}}`, `{"text":"[redacted]"}`},
+		{"no fields", `{"id":"1"}`, `{"id":"1"}`},
+		{"not json", `internal error`, `internal error`},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, string(r.Redact([]byte(test.body))), test.name)
+	}
+}
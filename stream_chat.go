@@ -2,6 +2,7 @@
 package stream_chat
 
 import (
+	"io"
 	"time"
 )
 
@@ -13,44 +14,139 @@ var (
 
 type StreamClient interface {
 	AddDevice(device *Device) error
+	AggregateMessageFlags(filter map[string]interface{}, since time.Time) (*FlagAggregate, error)
+	ArchiveAndDeleteChannel(chanType string, chanID string, opts *ArchiveOptions) (*ArchiveState, error)
 	BanUser(targetID string, userID string, options map[string]interface{}) error
+	BlockUser(targetID string, userID string) error
+	CheckModeration(req *ModerationCheckRequest) (*ModerationCheckResponse, error)
+	CheckPush(req *CheckPushRequest) (*CheckPushResponse, error)
+	CheckSNS(topicARN string, key string, secret string) (*CheckSNSResponse, error)
+	CheckSQS(sqsURL string, key string, secret string) (*CheckSQSResponse, error)
+	CountChannels(filter map[string]interface{}) (int, error)
+	CountUsers(filter map[string]interface{}) (int, error)
+	CreateBlocklist(name string, words []string) error
 	CreateChannel(chanType string, chanID string, userID string, data map[string]interface{}) (*Channel, error)
 	CreateChannelType(chType *ChannelType) (*ChannelType, error)
-	CreateToken(userID string, expire time.Time) ([]byte, error)
+	CreateTeamToken(userID string, teams []string, expire time.Time) ([]byte, error)
+	CreateToken(userID string, expire time.Time, issuedAt ...time.Time) ([]byte, error)
 	DeactivateUser(targetID string, options map[string]interface{}) error
+	DeactivateUsers(userIDs []string, options map[string]interface{}) (string, error)
+	DeleteBlocklist(name string) error
+	DeleteChannels(cids []string, hardDelete bool) (string, error)
 	DeleteChannelType(chType string) error
 	DeleteDevice(userID string, deviceID string) error
 	DeleteMessage(msgID string) error
 	DeleteUser(targetID string, options map[string][]string) error
-	ExportUser(targetID string, options map[string][]string) (user *User, err error)
-	FlagUser(targetID string, options map[string]interface{}) error
+	DeleteUsers(userIDs []string, options DeleteUsersOptions) (string, error)
+	EnsureChannels(inputs []*ChannelInput) []*EnsureChannelResult
+	ExportChannels(channels []*ExportChannelRequest, options *ExportChannelsOptions) (string, error)
+	ExportUser(targetID string, options map[string][]string) (*ExportUserResponse, error)
+	ExportUsers(userIDs ...string) (string, error)
+	FlagMessage(msgID string, userID string, options map[string]interface{}) error
+	FlagUser(targetID string, userID string, options map[string]interface{}) error
+	GetAppSettings() (*AppSettings, error)
+	GetBlockedUsers(userID string) ([]*BlockedUser, error)
+	GetBlocklist(name string) (*Blocklist, error)
 	GetChannelType(chanType string) (ct *ChannelType, err error)
 	GetDevices(userId string) (devices []*Device, err error)
+	GetDevicesForUsers(userIDs ...string) (map[string][]*Device, error)
+	GetExportChannelsStatus(taskID string) (*Task, error)
+	GetTask(taskID string) (*Task, error)
+	ImportUsers(users ...*User) (map[string]*User, error)
+	ListBlocklists() ([]*Blocklist, error)
 	ListChannelTypes() (map[string]*ChannelType, error)
 	MarkAllRead(userID string) error
-	MuteUser(targetID string, userID string) error
+	MuteChannel(cid string, userID string, expiration time.Duration) error
+	MuteChannels(filter map[string]interface{}, userID string, expiration time.Duration) (int, error)
+	MuteChannelsUntil(userID string, until time.Time) (int, error)
+	MuteUser(targetID string, userID string, options map[string]interface{}) (*Mute, error)
+	MuteUsers(targetIDs []string, userID string, options map[string]interface{}) ([]*Mute, error)
+	NewWebhookPipeline(stages ...WebhookStage) *WebhookPipeline
+	PartialUpdateMessage(msgID string, update PartialMessageUpdate, userID string) (*Message, error)
+	PartialUpdateUser(update PartialUserUpdate) (*User, error)
+	PartialUpdateUsers(updates ...PartialUserUpdate) (map[string]*User, error)
+	QueryBannedUsers(q *QueryOption, sort ...*SortOption) ([]*Ban, error)
+	QueryChannelMutes(userID string) ([]*ChannelMute, error)
+	QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel, error)
+	QueryFlagReports(q *QueryOption) ([]*FlagReport, error)
+	QueryInvites(userID string, status inviteStatus, q *QueryOption, sort ...*SortOption) ([]*Channel, error)
+	QueryMessageFlags(q *QueryOption) ([]*MessageFlag, error)
+	QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error)
+	ReactivateUser(targetID string, options map[string]interface{}) error
+	ReactivateUsers(userIDs []string, options map[string]interface{}) (string, error)
+	ReadOnly() ReadOnlyClient
+	RedeemJoinToken(token []byte, userID string) (*Channel, error)
+	RegisterChannelDataType(chanType string, prototype interface{}) error
+	Replay() (int, error)
+	ResolveMessageFlag(r *MessageFlagResolution) error
+	ReviewFlagReport(report *FlagReport, userID string, action FlagResolution, details map[string]interface{}) (*FlagReport, error)
+	RolloutConfigOverrides(filter map[string]interface{}, overrides map[string]interface{}, offset int, checkpoint func(offset int) error) (int, error)
+	SendUserCustomEvent(targetUserID string, event *UserCustomEvent) error
+	SetInvisible(userID string, invisible bool) (*User, error)
+	ShadowBan(targetID string, userID string, options map[string]interface{}) error
+	SharedChannels(userA string, userB string, chanType string) ([]*Channel, error)
+	SyncBlocklist(name string, r io.Reader) (*BlocklistSync, error)
+	SyncBlocklistFromURL(name string, url string, etag string) (*BlocklistSync, error)
+	TailMessages(opts TailOptions, sink MessageSink, done <-chan struct{}) (time.Time, error)
 	UnBanUser(targetID string, options map[string]string) error
-	UnFlagUser(targetID string, options map[string]interface{}) error
+	UnblockUser(targetID string, userID string) error
+	UnFlagMessage(msgID string, userID string) error
+	UnFlagUser(targetID string, userID string) error
+	UnmuteChannel(cid string, userID string) error
 	UnmuteUser(targetID string, userID string) error
+	UpdateAppSettings(settings *AppSettings) error
+	UpdateBlocklist(name string, words []string) error
+	UpdateChannelType(name string, options map[string]interface{}) error
 	UpdateMessage(msg *Message, msgID string) (*Message, error)
 	UpdateUsers(users ...*User) (map[string]*User, error)
+	UpsertUsers(users ...*User) (map[string]*User, error)
+	ValidateJoinToken(token []byte) (chanType string, chanID string, err error)
+	VerifyWebhook(body []byte, signature []byte) bool
+	WaitForTask(taskID string, pollInterval time.Duration, timeout time.Duration) (*Task, error)
 }
 
 type StreamChannel interface {
-	AddMembers(userIDs ...string) error
+	AcceptInvite(userID string, message *Message) error
+	AddMembers(userIDs []string, options *AddMemberOptions) error
 	AddModerators(userIDs ...string) error
+	Archive(userID string) error
+	AssignRoles(assignments []*RoleAssignment) error
 	BanUser(targetID string, userID string, options map[string]interface{}) error
-	Delete() error
+	CreateJoinToken(expire time.Time) ([]byte, error)
+	Delete(hard bool) error
 	DeleteReaction(messageID string, reactionType string, userID string) (*Message, error)
 	DemoteModerators(userIDs ...string) error
+	Disable() error
+	DisableSlowMode() error
+	Enable() error
+	EnableSlowMode(seconds int) error
+	Freeze(message *Message) error
+	GetPinnedMessages(limit int, before time.Time, sort ...*SortOption) ([]*Message, error)
 	GetReactions(messageID string, options map[string][]string) ([]*Reaction, error)
 	GetReplies(parentID string, options map[string][]string) (replies []*Message, err error)
-	MarkRead(userID string, options map[string]interface{}) error
-	RemoveMembers(userIDs ...string) error
+	Hide(userID string, clearHistory bool) error
+	InviteMembers(userIDs ...string) error
+	MarkRead(userID string, messageID string) error
+	MarkUnread(userID string, messageID string) error
+	PartialUpdate(set map[string]interface{}, unset []string) error
+	PartialUpdateMember(userID string, set map[string]interface{}, unset []string) (*ChannelMember, error)
+	Pin(userID string) error
+	QueryMembers(q *QueryOption, sort ...*SortOption) ([]*ChannelMember, error)
+	QueryPendingMessages(options map[string][]string) ([]*Message, error)
+	QueryWatchers(limit int, offset int) ([]*User, int, error)
+	Refresh() error
+	RejectInvite(userID string, message *Message) error
+	RemoveMembers(userIDs []string, message *Message) error
+	RemoveReactionsOfType(reactionType string) (int, error)
 	SendEvent(event *Event, userID string) error
-	SendMessage(message *Message, userID string) (*Message, error)
+	SendMessage(message *Message, userID string, opts ...SendMessageOption) (*Message, error)
 	SendReaction(reaction *Reaction, messageID string, userID string) (*Message, error)
-	Truncate() error
+	Show(userID string) error
+	Snapshot() *Channel
+	Truncate(options *TruncateOptions) error
+	Unarchive(userID string) error
 	UnBanUser(targetID string, options map[string]string) error
-	Update(options map[string]interface{}, message string) error
+	Unfreeze(message *Message) error
+	Unpin(userID string) error
+	Update(data map[string]interface{}, message *Message) error
 }
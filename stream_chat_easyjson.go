@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/getstream/easyjson"
 	jlexer "github.com/getstream/easyjson/jlexer"
 	jwriter "github.com/getstream/easyjson/jwriter"
+	url "net/url"
 	time "time"
 )
 
@@ -230,7 +231,107 @@ func (v *usersRequest) UnmarshalJSON(data []byte) error {
 func (v *usersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo1(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out *userRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out *usersImportRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Users = make(map[string]userImportRequest)
+				} else {
+					out.Users = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v5 userImportRequest
+					(v5).UnmarshalEasyJSON(in)
+					(out.Users)[key] = v5
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer, in usersImportRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix[1:])
+		if in.Users == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Users {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				(v6Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v usersImportRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v usersImportRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *usersImportRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *usersImportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out *userRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -258,10 +359,51 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 			out.Image = string(in.String())
 		case "role":
 			out.Role = string(in.String())
-		case "online":
-			out.Online = bool(in.Bool())
 		case "invisible":
 			out.Invisible = bool(in.Bool())
+		case "teams":
+			if in.IsNull() {
+				in.Skip()
+				out.Teams = nil
+			} else {
+				in.Delim('[')
+				if out.Teams == nil {
+					if !in.IsDelim(']') {
+						out.Teams = make([]string, 0, 4)
+					} else {
+						out.Teams = []string{}
+					}
+				} else {
+					out.Teams = (out.Teams)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Teams = append(out.Teams, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "teams_role":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.TeamsRole = make(map[string]string)
+				} else {
+					out.TeamsRole = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v8 string
+					v8 = string(in.String())
+					(out.TeamsRole)[key] = v8
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "mutes":
 			if in.IsNull() {
 				in.Skip()
@@ -278,17 +420,17 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 					out.Mutes = (out.Mutes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 *Mute
+					var v9 *Mute
 					if in.IsNull() {
 						in.Skip()
-						v5 = nil
+						v9 = nil
 					} else {
-						if v5 == nil {
-							v5 = new(Mute)
+						if v9 == nil {
+							v9 = new(Mute)
 						}
-						(*v5).UnmarshalEasyJSON(in)
+						(*v9).UnmarshalEasyJSON(in)
 					}
-					out.Mutes = append(out.Mutes, v5)
+					out.Mutes = append(out.Mutes, v9)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -303,7 +445,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer, in userRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(out *jwriter.Writer, in userRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -332,16 +474,44 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Role))
 	}
-	{
-		const prefix string = ",\"online\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Online))
-	}
 	{
 		const prefix string = ",\"invisible\":"
 		out.RawString(prefix)
 		out.Bool(bool(in.Invisible))
 	}
+	if len(in.Teams) != 0 {
+		const prefix string = ",\"teams\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v10, v11 := range in.Teams {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v11))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TeamsRole) != 0 {
+		const prefix string = ",\"teams_role\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v12First := true
+			for v12Name, v12Value := range in.TeamsRole {
+				if v12First {
+					v12First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v12Name))
+				out.RawByte(':')
+				out.String(string(v12Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"mutes\":"
 		out.RawString(prefix)
@@ -349,14 +519,14 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Mutes {
-				if v6 > 0 {
+			for v13, v14 := range in.Mutes {
+				if v13 > 0 {
 					out.RawByte(',')
 				}
-				if v7 == nil {
+				if v14 == nil {
 					out.RawString("null")
 				} else {
-					(*v7).MarshalEasyJSON(out)
+					(*v14).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -368,27 +538,27 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v userRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v userRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *userRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *userRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out *repliesResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out *userImportRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -397,6 +567,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out
 		in.Skip()
 		return
 	}
+	out.User = new(User)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -407,33 +578,110 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "messages":
+		case "created_at":
 			if in.IsNull() {
 				in.Skip()
-				out.Messages = nil
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "last_active":
+			if in.IsNull() {
+				in.Skip()
+				out.LastActive = nil
+			} else {
+				if out.LastActive == nil {
+					out.LastActive = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastActive).UnmarshalJSON(data))
+				}
+			}
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "invisible":
+			out.Invisible = bool(in.Bool())
+		case "teams":
+			if in.IsNull() {
+				in.Skip()
+				out.Teams = nil
 			} else {
 				in.Delim('[')
-				if out.Messages == nil {
+				if out.Teams == nil {
 					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
+						out.Teams = make([]string, 0, 4)
 					} else {
-						out.Messages = []*Message{}
+						out.Teams = []string{}
 					}
 				} else {
-					out.Messages = (out.Messages)[:0]
+					out.Teams = (out.Teams)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 string
+					v15 = string(in.String())
+					out.Teams = append(out.Teams, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "teams_role":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.TeamsRole = make(map[string]string)
+				} else {
+					out.TeamsRole = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v16 string
+					v16 = string(in.String())
+					(out.TeamsRole)[key] = v16
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.Mutes = nil
+			} else {
+				in.Delim('[')
+				if out.Mutes == nil {
+					if !in.IsDelim(']') {
+						out.Mutes = make([]*Mute, 0, 8)
+					} else {
+						out.Mutes = []*Mute{}
+					}
+				} else {
+					out.Mutes = (out.Mutes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v8 *Message
+					var v17 *Mute
 					if in.IsNull() {
 						in.Skip()
-						v8 = nil
+						v17 = nil
 					} else {
-						if v8 == nil {
-							v8 = new(Message)
+						if v17 == nil {
+							v17 = new(Mute)
 						}
-						(*v8).UnmarshalEasyJSON(in)
+						(*v17).UnmarshalEasyJSON(in)
 					}
-					out.Messages = append(out.Messages, v8)
+					out.Mutes = append(out.Mutes, v17)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -448,57 +696,140 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(out *jwriter.Writer, in repliesResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(out *jwriter.Writer, in userImportRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	if in.CreatedAt != nil {
+		const prefix string = ",\"created_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	if in.LastActive != nil {
+		const prefix string = ",\"last_active\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.LastActive).MarshalJSON())
+	}
 	{
-		const prefix string = ",\"messages\":"
-		out.RawString(prefix[1:])
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v9, v10 := range in.Messages {
-				if v9 > 0 {
-					out.RawByte(',')
-				}
-				if v10 == nil {
-					out.RawString("null")
-				} else {
-					(*v10).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.String(string(in.ID))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v repliesResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"invisible\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Invisible))
+	}
+	if len(in.Teams) != 0 {
+		const prefix string = ",\"teams\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v18, v19 := range in.Teams {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v19))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TeamsRole) != 0 {
+		const prefix string = ",\"teams_role\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v20First := true
+			for v20Name, v20Value := range in.TeamsRole {
+				if v20First {
+					v20First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v20Name))
+				out.RawByte(':')
+				out.String(string(v20Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		if in.Mutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Mutes {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				if v22 == nil {
+					out.RawString("null")
+				} else {
+					(*v22).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v userImportRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repliesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(w, v)
+func (v userImportRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repliesResponse) UnmarshalJSON(data []byte) error {
+func (v *userImportRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repliesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(l, v)
+func (v *userImportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out *reactionsResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out *userCustomEventRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -517,36 +848,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "reactions":
+		case "event":
 			if in.IsNull() {
 				in.Skip()
-				out.Reactions = nil
+				out.Event = nil
 			} else {
-				in.Delim('[')
-				if out.Reactions == nil {
-					if !in.IsDelim(']') {
-						out.Reactions = make([]*Reaction, 0, 8)
-					} else {
-						out.Reactions = []*Reaction{}
-					}
-				} else {
-					out.Reactions = (out.Reactions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v11 *Reaction
-					if in.IsNull() {
-						in.Skip()
-						v11 = nil
-					} else {
-						if v11 == nil {
-							v11 = new(Reaction)
-						}
-						(*v11).UnmarshalEasyJSON(in)
-					}
-					out.Reactions = append(out.Reactions, v11)
-					in.WantComma()
+				if out.Event == nil {
+					out.Event = new(UserCustomEvent)
 				}
-				in.Delim(']')
+				(*out.Event).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -558,57 +868,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(out *jwriter.Writer, in reactionsResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(out *jwriter.Writer, in userCustomEventRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"reactions\":"
+		const prefix string = ",\"event\":"
 		out.RawString(prefix[1:])
-		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Event == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v12, v13 := range in.Reactions {
-				if v12 > 0 {
-					out.RawByte(',')
-				}
-				if v13 == nil {
-					out.RawString("null")
-				} else {
-					(*v13).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+			(*in.Event).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionsResponse) MarshalJSON() ([]byte, error) {
+func (v userCustomEventRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(w, v)
+func (v userCustomEventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionsResponse) UnmarshalJSON(data []byte) error {
+func (v *userCustomEventRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(l, v)
+func (v *userCustomEventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out *reactionResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out *taskResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -627,26 +926,8 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "message":
-			if in.IsNull() {
-				in.Skip()
-				out.Message = nil
-			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
-				}
-				(*out.Message).UnmarshalEasyJSON(in)
-			}
-		case "reaction":
-			if in.IsNull() {
-				in.Skip()
-				out.Reaction = nil
-			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
-				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
-			}
+		case "task_id":
+			out.TaskID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -657,55 +938,101 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(out *jwriter.Writer, in reactionResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(out *jwriter.Writer, in taskResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		if in.Message == nil {
-			out.RawString("null")
-		} else {
-			(*in.Message).MarshalEasyJSON(out)
+		out.String(string(in.TaskID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v taskResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v taskResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *taskResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *taskResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out *statusError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"reaction\":"
-		out.RawString(prefix)
-		if in.Reaction == nil {
-			out.RawString("null")
-		} else {
-			(*in.Reaction).MarshalEasyJSON(out)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(out *jwriter.Writer, in statusError) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionResponse) MarshalJSON() ([]byte, error) {
+func (v statusError) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(w, v)
+func (v statusError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionResponse) UnmarshalJSON(data []byte) error {
+func (v *statusError) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(l, v)
+func (v *statusError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out *reactionRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out *searchResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -724,15 +1051,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "reaction":
+		case "message":
 			if in.IsNull() {
 				in.Skip()
-				out.Reaction = nil
+				out.Message = nil
 			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
+				if out.Message == nil {
+					out.Message = new(Message)
 				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
+				(*out.Message).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -744,46 +1071,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(out *jwriter.Writer, in reactionRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(out *jwriter.Writer, in searchResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"reaction\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		if in.Reaction == nil {
+		if in.Message == nil {
 			out.RawString("null")
 		} else {
-			(*in.Reaction).MarshalEasyJSON(out)
+			(*in.Message).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionRequest) MarshalJSON() ([]byte, error) {
+func (v searchResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(w, v)
+func (v searchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionRequest) UnmarshalJSON(data []byte) error {
+func (v *searchResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(l, v)
+func (v *searchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out *queryResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out *searchResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -802,109 +1129,203 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "channel":
+		case "results":
 			if in.IsNull() {
 				in.Skip()
-				out.Channel = nil
+				out.Results = nil
 			} else {
-				if out.Channel == nil {
-					out.Channel = new(Channel)
-				}
-				(*out.Channel).UnmarshalEasyJSON(in)
-			}
-		case "messages":
-			if in.IsNull() {
-				in.Skip()
-				out.Messages = nil
-			} else {
-				in.Delim('[')
-				if out.Messages == nil {
-					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
-					} else {
-						out.Messages = []*Message{}
-					}
-				} else {
-					out.Messages = (out.Messages)[:0]
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]*searchResult, 0, 8)
+					} else {
+						out.Results = []*searchResult{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v14 *Message
+					var v23 *searchResult
 					if in.IsNull() {
 						in.Skip()
-						v14 = nil
+						v23 = nil
 					} else {
-						if v14 == nil {
-							v14 = new(Message)
+						if v23 == nil {
+							v23 = new(searchResult)
 						}
-						(*v14).UnmarshalEasyJSON(in)
+						(*v23).UnmarshalEasyJSON(in)
 					}
-					out.Messages = append(out.Messages, v14)
+					out.Results = append(out.Results, v23)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "members":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(out *jwriter.Writer, in searchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Results {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				if v25 == nil {
+					out.RawString("null")
+				} else {
+					(*v25).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v searchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *searchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, out *searchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
-				out.Members = nil
 			} else {
-				in.Delim('[')
-				if out.Members == nil {
-					if !in.IsDelim(']') {
-						out.Members = make([]*ChannelMember, 0, 8)
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
+				} else {
+					out.FilterConditions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v26 interface{}
+					if m, ok := v26.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v26.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						out.Members = []*ChannelMember{}
+						v26 = in.Interface()
 					}
+					(out.FilterConditions)[key] = v26
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "message_filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.MessageFilterConditions = make(map[string]interface{})
 				} else {
-					out.Members = (out.Members)[:0]
+					out.MessageFilterConditions = nil
 				}
-				for !in.IsDelim(']') {
-					var v15 *ChannelMember
-					if in.IsNull() {
-						in.Skip()
-						v15 = nil
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v27 interface{}
+					if m, ok := v27.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v27.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						if v15 == nil {
-							v15 = new(ChannelMember)
-						}
-						(*v15).UnmarshalEasyJSON(in)
+						v27 = in.Interface()
 					}
-					out.Members = append(out.Members, v15)
+					(out.MessageFilterConditions)[key] = v27
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
-		case "read":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
-				out.Read = nil
+				out.Sort = nil
 			} else {
 				in.Delim('[')
-				if out.Read == nil {
+				if out.Sort == nil {
 					if !in.IsDelim(']') {
-						out.Read = make([]*User, 0, 8)
+						out.Sort = make([]*SortOption, 0, 8)
 					} else {
-						out.Read = []*User{}
+						out.Sort = []*SortOption{}
 					}
 				} else {
-					out.Read = (out.Read)[:0]
+					out.Sort = (out.Sort)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 *User
+					var v28 *SortOption
 					if in.IsNull() {
 						in.Skip()
-						v16 = nil
+						v28 = nil
 					} else {
-						if v16 == nil {
-							v16 = new(User)
+						if v28 == nil {
+							v28 = new(SortOption)
 						}
-						(*v16).UnmarshalEasyJSON(in)
+						(*v28).UnmarshalEasyJSON(in)
 					}
-					out.Read = append(out.Read, v16)
+					out.Sort = append(out.Sort, v28)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -915,112 +1336,119 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(out *jwriter.Writer, in queryResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(out *jwriter.Writer, in searchRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Channel != nil {
-		const prefix string = ",\"channel\":"
-		first = false
+	{
+		const prefix string = ",\"filter_conditions\":"
 		out.RawString(prefix[1:])
-		(*in.Channel).MarshalEasyJSON(out)
-	}
-	if len(in.Messages) != 0 {
-		const prefix string = ",\"messages\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		if in.FilterConditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v17, v18 := range in.Messages {
-				if v17 > 0 {
+			out.RawByte('{')
+			v29First := true
+			for v29Name, v29Value := range in.FilterConditions {
+				if v29First {
+					v29First = false
+				} else {
 					out.RawByte(',')
 				}
-				if v18 == nil {
-					out.RawString("null")
+				out.String(string(v29Name))
+				out.RawByte(':')
+				if m, ok := v29Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v29Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
 				} else {
-					(*v18).MarshalEasyJSON(out)
+					out.Raw(json.Marshal(v29Value))
 				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	if len(in.Members) != 0 {
-		const prefix string = ",\"members\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+	{
+		const prefix string = ",\"message_filter_conditions\":"
+		out.RawString(prefix)
+		if in.MessageFilterConditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v19, v20 := range in.Members {
-				if v19 > 0 {
+			out.RawByte('{')
+			v30First := true
+			for v30Name, v30Value := range in.MessageFilterConditions {
+				if v30First {
+					v30First = false
+				} else {
 					out.RawByte(',')
 				}
-				if v20 == nil {
-					out.RawString("null")
+				out.String(string(v30Name))
+				out.RawByte(':')
+				if m, ok := v30Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v30Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
 				} else {
-					(*v20).MarshalEasyJSON(out)
+					out.Raw(json.Marshal(v30Value))
 				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	if len(in.Read) != 0 {
-		const prefix string = ",\"read\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v21, v22 := range in.Read {
-				if v21 > 0 {
+			for v31, v32 := range in.Sort {
+				if v31 > 0 {
 					out.RawByte(',')
 				}
-				if v22 == nil {
+				if v32 == nil {
 					out.RawString("null")
 				} else {
-					(*v22).MarshalEasyJSON(out)
+					(*v32).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryResponse) MarshalJSON() ([]byte, error) {
+func (v searchRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(w, v)
+func (v searchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryResponse) UnmarshalJSON(data []byte) error {
+func (v *searchRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(l, v)
+func (v *searchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out *messageResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, out *repliesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1039,15 +1467,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "message":
+		case "messages":
 			if in.IsNull() {
 				in.Skip()
-				out.Message = nil
+				out.Messages = nil
 			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
 				}
-				(*out.Message).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v33 *Message
+					if in.IsNull() {
+						in.Skip()
+						v33 = nil
+					} else {
+						if v33 == nil {
+							v33 = new(Message)
+						}
+						(*v33).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1059,46 +1508,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(out *jwriter.Writer, in messageResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(out *jwriter.Writer, in repliesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"messages\":"
 		out.RawString(prefix[1:])
-		if in.Message == nil {
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			(*in.Message).MarshalEasyJSON(out)
+			out.RawByte('[')
+			for v34, v35 := range in.Messages {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				if v35 == nil {
+					out.RawString("null")
+				} else {
+					(*v35).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageResponse) MarshalJSON() ([]byte, error) {
+func (v repliesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(w, v)
+func (v repliesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageResponse) UnmarshalJSON(data []byte) error {
+func (v *repliesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(l, v)
+func (v *repliesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out *messageRequestUser) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, out *readGroup) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1117,8 +1577,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1129,42 +1587,37 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(out *jwriter.Writer, in messageRequestUser) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(out *jwriter.Writer, in readGroup) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequestUser) MarshalJSON() ([]byte, error) {
+func (v readGroup) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequestUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(w, v)
+func (v readGroup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequestUser) UnmarshalJSON(data []byte) error {
+func (v *readGroup) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequestUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(l, v)
+func (v *readGroup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, out *messageRequestMessage) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, out *readCall) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1182,77 +1635,99 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, ou
 			in.WantComma()
 			continue
 		}
-		for key := range out.ExtraData {
-			delete(out.ExtraData, key)
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(out *jwriter.Writer, in readCall) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v readCall) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v readCall) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *readCall) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *readCall) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, out *reactionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
 		}
 		switch key {
-		case "text":
-			out.Text = string(in.String())
-		case "attachments":
+		case "reactions":
 			if in.IsNull() {
 				in.Skip()
-				out.Attachments = nil
+				out.Reactions = nil
 			} else {
 				in.Delim('[')
-				if out.Attachments == nil {
+				if out.Reactions == nil {
 					if !in.IsDelim(']') {
-						out.Attachments = make([]*Attachment, 0, 8)
+						out.Reactions = make([]*Reaction, 0, 8)
 					} else {
-						out.Attachments = []*Attachment{}
+						out.Reactions = []*Reaction{}
 					}
 				} else {
-					out.Attachments = (out.Attachments)[:0]
+					out.Reactions = (out.Reactions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v23 *Attachment
+					var v36 *Reaction
 					if in.IsNull() {
 						in.Skip()
-						v23 = nil
+						v36 = nil
 					} else {
-						if v23 == nil {
-							v23 = new(Attachment)
+						if v36 == nil {
+							v36 = new(Reaction)
 						}
-						(*v23).UnmarshalEasyJSON(in)
-					}
-					out.Attachments = append(out.Attachments, v23)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "mentioned_users":
-			if in.IsNull() {
-				in.Skip()
-				out.MentionedUsers = nil
-			} else {
-				in.Delim('[')
-				if out.MentionedUsers == nil {
-					if !in.IsDelim(']') {
-						out.MentionedUsers = make([]string, 0, 4)
-					} else {
-						out.MentionedUsers = []string{}
+						(*v36).UnmarshalEasyJSON(in)
 					}
-				} else {
-					out.MentionedUsers = (out.MentionedUsers)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v24 string
-					v24 = string(in.String())
-					out.MentionedUsers = append(out.MentionedUsers, v24)
+					out.Reactions = append(out.Reactions, v36)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "parent_id":
-			out.ParentID = string(in.String())
-		case "show_in_channel":
-			out.ShowInChannel = bool(in.Bool())
 		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
-			}
-			out.ExtraData[key] = in.Interface()
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -1261,109 +1736,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(out *jwriter.Writer, in messageRequestMessage) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(out *jwriter.Writer, in reactionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"reactions\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"attachments\":"
-		out.RawString(prefix)
-		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Attachments {
-				if v25 > 0 {
+			for v37, v38 := range in.Reactions {
+				if v37 > 0 {
 					out.RawByte(',')
 				}
-				if v26 == nil {
+				if v38 == nil {
 					out.RawString("null")
 				} else {
-					(*v26).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"user\":"
-		out.RawString(prefix)
-		(in.User).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"mentioned_users\":"
-		out.RawString(prefix)
-		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v27, v28 := range in.MentionedUsers {
-				if v27 > 0 {
-					out.RawByte(',')
+					(*v38).MarshalEasyJSON(out)
 				}
-				out.String(string(v28))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"parent_id\":"
-		out.RawString(prefix)
-		out.String(string(in.ParentID))
-	}
-	{
-		const prefix string = ",\"show_in_channel\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ShowInChannel))
-	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "text", "attachments", "user", "mentioned_users", "parent_id", "show_in_channel":
-			continue // don't allow field overwrites
-		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(v))
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequestMessage) MarshalJSON() ([]byte, error) {
+func (v reactionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequestMessage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(w, v)
+func (v reactionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequestMessage) UnmarshalJSON(data []byte) error {
+func (v *reactionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequestMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(l, v)
+func (v *reactionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, out *messageRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, out *reactionResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1383,7 +1806,25 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, ou
 		}
 		switch key {
 		case "message":
-			(out.Message).UnmarshalEasyJSON(in)
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reaction":
+			if in.IsNull() {
+				in.Skip()
+				out.Reaction = nil
+			} else {
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
+				}
+				(*out.Reaction).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1394,42 +1835,55 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(out *jwriter.Writer, in messageRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(out *jwriter.Writer, in reactionResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		(in.Message).MarshalEasyJSON(out)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix)
+		if in.Reaction == nil {
+			out.RawString("null")
+		} else {
+			(*in.Reaction).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequest) MarshalJSON() ([]byte, error) {
+func (v reactionResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(w, v)
+func (v reactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequest) UnmarshalJSON(data []byte) error {
+func (v *reactionResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(l, v)
+func (v *reactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, out *eventRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, out *reactionRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1448,15 +1902,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "event":
+		case "reaction":
 			if in.IsNull() {
 				in.Skip()
-				out.Event = nil
+				out.Reaction = nil
 			} else {
-				if out.Event == nil {
-					out.Event = new(Event)
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
 				}
-				(*out.Event).UnmarshalEasyJSON(in)
+				(*out.Reaction).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -1468,46 +1922,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(out *jwriter.Writer, in eventRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(out *jwriter.Writer, in reactionRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"event\":"
+		const prefix string = ",\"reaction\":"
 		out.RawString(prefix[1:])
-		if in.Event == nil {
+		if in.Reaction == nil {
 			out.RawString("null")
 		} else {
-			(*in.Event).MarshalEasyJSON(out)
+			(*in.Reaction).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v eventRequest) MarshalJSON() ([]byte, error) {
+func (v reactionRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v eventRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(w, v)
+func (v reactionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *eventRequest) UnmarshalJSON(data []byte) error {
+func (v *reactionRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *eventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(l, v)
+func (v *reactionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, out *devicesResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, out *rateLimitBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1526,37 +1980,10 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "devices":
-			if in.IsNull() {
-				in.Skip()
-				out.Devices = nil
-			} else {
-				in.Delim('[')
-				if out.Devices == nil {
-					if !in.IsDelim(']') {
-						out.Devices = make([]*Device, 0, 8)
-					} else {
-						out.Devices = []*Device{}
-					}
-				} else {
-					out.Devices = (out.Devices)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v29 *Device
-					if in.IsNull() {
-						in.Skip()
-						v29 = nil
-					} else {
-						if v29 == nil {
-							v29 = new(Device)
-						}
-						(*v29).UnmarshalEasyJSON(in)
-					}
-					out.Devices = append(out.Devices, v29)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "retry_after":
+			out.RetryAfter = float64(in.Float64())
+		case "reset":
+			out.Reset = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1567,57 +1994,47 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(out *jwriter.Writer, in devicesResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(out *jwriter.Writer, in rateLimitBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"devices\":"
+		const prefix string = ",\"retry_after\":"
 		out.RawString(prefix[1:])
-		if in.Devices == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v30, v31 := range in.Devices {
-				if v30 > 0 {
-					out.RawByte(',')
-				}
-				if v31 == nil {
-					out.RawString("null")
-				} else {
-					(*v31).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Float64(float64(in.RetryAfter))
+	}
+	{
+		const prefix string = ",\"reset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Reset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v devicesResponse) MarshalJSON() ([]byte, error) {
+func (v rateLimitBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v devicesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(w, v)
+func (v rateLimitBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *devicesResponse) UnmarshalJSON(data []byte) error {
+func (v *rateLimitBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *devicesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(l, v)
+func (v *rateLimitBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, out *channelTypeResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, out *queryUsersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1636,33 +2053,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "channel_types":
+		case "users":
 			if in.IsNull() {
 				in.Skip()
+				out.Users = nil
 			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.ChannelTypes = make(map[string]*ChannelType)
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]*User, 0, 8)
+					} else {
+						out.Users = []*User{}
+					}
 				} else {
-					out.ChannelTypes = nil
+					out.Users = (out.Users)[:0]
 				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v32 *ChannelType
+				for !in.IsDelim(']') {
+					var v39 *User
 					if in.IsNull() {
 						in.Skip()
-						v32 = nil
+						v39 = nil
 					} else {
-						if v32 == nil {
-							v32 = new(ChannelType)
+						if v39 == nil {
+							v39 = new(User)
 						}
-						(*v32).UnmarshalEasyJSON(in)
+						(*v39).UnmarshalEasyJSON(in)
 					}
-					(out.ChannelTypes)[key] = v32
+					out.Users = append(out.Users, v39)
 					in.WantComma()
 				}
-				in.Delim('}')
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1674,62 +2094,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(out *jwriter.Writer, in channelTypeResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(out *jwriter.Writer, in queryUsersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"channel_types\":"
+		const prefix string = ",\"users\":"
 		out.RawString(prefix[1:])
-		if in.ChannelTypes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawByte('{')
-			v33First := true
-			for v33Name, v33Value := range in.ChannelTypes {
-				if v33First {
-					v33First = false
-				} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Users {
+				if v40 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v33Name))
-				out.RawByte(':')
-				if v33Value == nil {
+				if v41 == nil {
 					out.RawString("null")
 				} else {
-					(*v33Value).MarshalEasyJSON(out)
+					(*v41).MarshalEasyJSON(out)
 				}
 			}
-			out.RawByte('}')
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v channelTypeResponse) MarshalJSON() ([]byte, error) {
+func (v queryUsersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v channelTypeResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(w, v)
+func (v queryUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *channelTypeResponse) UnmarshalJSON(data []byte) error {
+func (v *queryUsersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *channelTypeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(l, v)
+func (v *queryUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, out *channelTypeRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, out *queryUsersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1738,7 +2153,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	out.ChannelType = new(ChannelType)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -1749,84 +2163,69 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "commands":
+		case "presence":
+			out.Presence = bool(in.Bool())
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
-				out.Commands = nil
 			} else {
-				in.Delim('[')
-				if out.Commands == nil {
-					if !in.IsDelim(']') {
-						out.Commands = make([]string, 0, 4)
-					} else {
-						out.Commands = []string{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
 				} else {
-					out.Commands = (out.Commands)[:0]
+					out.FilterConditions = nil
 				}
-				for !in.IsDelim(']') {
-					var v34 string
-					v34 = string(in.String())
-					out.Commands = append(out.Commands, v34)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v42 interface{}
+					if m, ok := v42.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v42.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v42 = in.Interface()
+					}
+					(out.FilterConditions)[key] = v42
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
-		case "permissions":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
-				out.Permissions = nil
+				out.Sort = nil
 			} else {
 				in.Delim('[')
-				if out.Permissions == nil {
+				if out.Sort == nil {
 					if !in.IsDelim(']') {
-						out.Permissions = make([]*Permission, 0, 8)
+						out.Sort = make([]*SortOption, 0, 8)
 					} else {
-						out.Permissions = []*Permission{}
+						out.Sort = []*SortOption{}
 					}
 				} else {
-					out.Permissions = (out.Permissions)[:0]
+					out.Sort = (out.Sort)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v35 *Permission
+					var v43 *SortOption
 					if in.IsNull() {
 						in.Skip()
-						v35 = nil
+						v43 = nil
 					} else {
-						if v35 == nil {
-							v35 = new(Permission)
+						if v43 == nil {
+							v43 = new(SortOption)
 						}
-						(*v35).UnmarshalEasyJSON(in)
+						(*v43).UnmarshalEasyJSON(in)
 					}
-					out.Permissions = append(out.Permissions, v35)
+					out.Sort = append(out.Sort, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1837,133 +2236,95 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(out *jwriter.Writer, in channelTypeRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(out *jwriter.Writer, in queryUsersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commands\":"
+		const prefix string = ",\"presence\":"
 		out.RawString(prefix[1:])
-		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v36, v37 := range in.Commands {
-				if v36 > 0 {
+		out.Bool(bool(in.Presence))
+	}
+	if len(in.FilterConditions) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v44First := true
+			for v44Name, v44Value := range in.FilterConditions {
+				if v44First {
+					v44First = false
+				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v37))
+				out.String(string(v44Name))
+				out.RawByte(':')
+				if m, ok := v44Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v44Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v44Value))
+				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	{
-		const prefix string = ",\"permissions\":"
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
 		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		{
 			out.RawByte('[')
-			for v38, v39 := range in.Permissions {
-				if v38 > 0 {
+			for v45, v46 := range in.Sort {
+				if v45 > 0 {
 					out.RawByte(',')
 				}
-				if v39 == nil {
+				if v46 == nil {
 					out.RawString("null")
 				} else {
-					(*v39).MarshalEasyJSON(out)
+					(*v46).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"typing_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
-	}
-	{
-		const prefix string = ",\"read_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
-	}
-	{
-		const prefix string = ",\"connect_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
-	}
-	{
-		const prefix string = ",\"search\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Search))
-	}
-	{
-		const prefix string = ",\"reactions\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
-	}
-	{
-		const prefix string = ",\"replies\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
-	}
-	{
-		const prefix string = ",\"mutes\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
-	}
-	{
-		const prefix string = ",\"message_retention\":"
-		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
-	}
-	{
-		const prefix string = ",\"max_message_length\":"
-		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
-	}
-	{
-		const prefix string = ",\"automod\":"
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		out.String(string(in.Automod))
+		out.Int(int(in.Limit))
 	}
-	{
-		const prefix string = ",\"automod_behavior\":"
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
 		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
+		out.Int(int(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v channelTypeRequest) MarshalJSON() ([]byte, error) {
+func (v queryUsersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v channelTypeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(w, v)
+func (v queryUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *channelTypeRequest) UnmarshalJSON(data []byte) error {
+func (v *queryUsersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *channelTypeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(l, v)
+func (v *queryUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, out *User) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, out *queryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1981,70 +2342,137 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, ou
 			in.WantComma()
 			continue
 		}
-		for key := range out.ExtraData {
-			delete(out.ExtraData, key)
-		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "image":
-			out.Image = string(in.String())
-		case "role":
-			out.Role = string(in.String())
-		case "online":
-			out.Online = bool(in.Bool())
-		case "invisible":
-			out.Invisible = bool(in.Bool())
-		case "mutes":
+		case "channel":
+			(out.Channel).UnmarshalEasyJSON(in)
+		case "messages":
 			if in.IsNull() {
 				in.Skip()
-				out.Mutes = nil
+				out.Messages = nil
 			} else {
 				in.Delim('[')
-				if out.Mutes == nil {
+				if out.Messages == nil {
 					if !in.IsDelim(']') {
-						out.Mutes = make([]*Mute, 0, 8)
+						out.Messages = make([]*Message, 0, 8)
 					} else {
-						out.Mutes = []*Mute{}
+						out.Messages = []*Message{}
 					}
 				} else {
-					out.Mutes = (out.Mutes)[:0]
+					out.Messages = (out.Messages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 *Mute
+					var v47 *Message
 					if in.IsNull() {
 						in.Skip()
-						v40 = nil
+						v47 = nil
 					} else {
-						if v40 == nil {
-							v40 = new(Mute)
+						if v47 == nil {
+							v47 = new(Message)
 						}
-						(*v40).UnmarshalEasyJSON(in)
+						(*v47).UnmarshalEasyJSON(in)
 					}
-					out.Mutes = append(out.Mutes, v40)
+					out.Messages = append(out.Messages, v47)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v48 = nil
+					} else {
+						if v48 == nil {
+							v48 = new(ChannelMember)
+						}
+						(*v48).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "read":
+			if in.IsNull() {
+				in.Skip()
+				out.Read = nil
+			} else {
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*User, 0, 8)
+					} else {
+						out.Read = []*User{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 *User
+					if in.IsNull() {
+						in.Skip()
+						v49 = nil
+					} else {
+						if v49 == nil {
+							v49 = new(User)
+						}
+						(*v49).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "last_active":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastActive).UnmarshalJSON(data))
+		case "watchers":
+			if in.IsNull() {
+				in.Skip()
+				out.Watchers = nil
+			} else {
+				in.Delim('[')
+				if out.Watchers == nil {
+					if !in.IsDelim(']') {
+						out.Watchers = make([]*User, 0, 8)
+					} else {
+						out.Watchers = []*User{}
+					}
+				} else {
+					out.Watchers = (out.Watchers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v50 *User
+					if in.IsNull() {
+						in.Skip()
+						v50 = nil
+					} else {
+						if v50 == nil {
+							v50 = new(User)
+						}
+						(*v50).UnmarshalEasyJSON(in)
+					}
+					out.Watchers = append(out.Watchers, v50)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
+		case "watcher_count":
+			out.WatcherCount = int(in.Int())
 		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
-			}
-			out.ExtraData[key] = in.Interface()
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -2053,118 +2481,145 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(out *jwriter.Writer, in User) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(out *jwriter.Writer, in queryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
+	if (in.Channel).IsDefined() {
+		const prefix string = ",\"channel\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"image\":"
-		out.RawString(prefix)
-		out.String(string(in.Image))
+		(in.Channel).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"role\":"
-		out.RawString(prefix)
-		out.String(string(in.Role))
-	}
-	{
-		const prefix string = ",\"online\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Online))
-	}
-	{
-		const prefix string = ",\"invisible\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Invisible))
-	}
-	{
-		const prefix string = ",\"mutes\":"
-		out.RawString(prefix)
-		if in.Mutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+	if len(in.Messages) != 0 {
+		const prefix string = ",\"messages\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
+			out.RawString(prefix)
+		}
+		{
 			out.RawByte('[')
-			for v41, v42 := range in.Mutes {
-				if v41 > 0 {
+			for v51, v52 := range in.Messages {
+				if v51 > 0 {
 					out.RawByte(',')
 				}
-				if v42 == nil {
+				if v52 == nil {
 					out.RawString("null")
 				} else {
-					(*v42).MarshalEasyJSON(out)
+					(*v52).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	if len(in.Members) != 0 {
+		const prefix string = ",\"members\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v53, v54 := range in.Members {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				if v54 == nil {
+					out.RawString("null")
+				} else {
+					(*v54).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"last_active\":"
-		out.RawString(prefix)
-		out.Raw((in.LastActive).MarshalJSON())
+	if len(in.Read) != 0 {
+		const prefix string = ",\"read\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v55, v56 := range in.Read {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				if v56 == nil {
+					out.RawString("null")
+				} else {
+					(*v56).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "id", "name", "image", "role", "online", "invisible", "mutes", "created_at", "updated_at", "last_active":
-			continue // don't allow field overwrites
+	if len(in.Watchers) != 0 {
+		const prefix string = ",\"watchers\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		{
+			out.RawByte('[')
+			for v57, v58 := range in.Watchers {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				if v58 == nil {
+					out.RawString("null")
+				} else {
+					(*v58).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.WatcherCount != 0 {
+		const prefix string = ",\"watcher_count\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.Raw(json.Marshal(v))
+			out.RawString(prefix)
 		}
+		out.Int(int(in.WatcherCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v User) MarshalJSON() ([]byte, error) {
+func (v queryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(w, v)
+func (v queryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *User) UnmarshalJSON(data []byte) error {
+func (v *queryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(l, v)
+func (v *queryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, out *Reaction) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, out *queryMessageFlagsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2182,21 +2637,40 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, ou
 			in.WantComma()
 			continue
 		}
-		for key := range out.ExtraData {
-			delete(out.ExtraData, key)
-		}
 		switch key {
-		case "message_id":
-			out.MessageID = string(in.String())
-		case "user_id":
-			out.UserID = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
+		case "flags":
+			if in.IsNull() {
+				in.Skip()
+				out.Flags = nil
+			} else {
+				in.Delim('[')
+				if out.Flags == nil {
+					if !in.IsDelim(']') {
+						out.Flags = make([]*MessageFlag, 0, 8)
+					} else {
+						out.Flags = []*MessageFlag{}
+					}
+				} else {
+					out.Flags = (out.Flags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 *MessageFlag
+					if in.IsNull() {
+						in.Skip()
+						v59 = nil
+					} else {
+						if v59 == nil {
+							v59 = new(MessageFlag)
+						}
+						(*v59).UnmarshalEasyJSON(in)
+					}
+					out.Flags = append(out.Flags, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-			out.ExtraData[key] = in.Interface()
+		default:
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -2205,68 +2679,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(out *jwriter.Writer, in Reaction) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(out *jwriter.Writer, in queryMessageFlagsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message_id\":"
+		const prefix string = ",\"flags\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.MessageID))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.String(string(in.UserID))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "message_id", "user_id", "type":
-			continue // don't allow field overwrites
-		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Flags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(v))
+			out.RawByte('[')
+			for v60, v61 := range in.Flags {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				if v61 == nil {
+					out.RawString("null")
+				} else {
+					(*v61).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Reaction) MarshalJSON() ([]byte, error) {
+func (v queryMessageFlagsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Reaction) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(w, v)
+func (v queryMessageFlagsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Reaction) UnmarshalJSON(data []byte) error {
+func (v *queryMessageFlagsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Reaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(l, v)
+func (v *queryMessageFlagsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, out *Permission) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, out *queryMessageFlagsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2285,60 +2748,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "action":
-			out.Action = string(in.String())
-		case "resources":
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
-				out.Resources = nil
 			} else {
-				in.Delim('[')
-				if out.Resources == nil {
-					if !in.IsDelim(']') {
-						out.Resources = make([]string, 0, 4)
-					} else {
-						out.Resources = []string{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
 				} else {
-					out.Resources = (out.Resources)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v43 string
-					v43 = string(in.String())
-					out.Resources = append(out.Resources, v43)
-					in.WantComma()
+					out.FilterConditions = nil
 				}
-				in.Delim(']')
-			}
-		case "roles":
-			if in.IsNull() {
-				in.Skip()
-				out.Roles = nil
-			} else {
-				in.Delim('[')
-				if out.Roles == nil {
-					if !in.IsDelim(']') {
-						out.Roles = make([]string, 0, 4)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v62 interface{}
+					if m, ok := v62.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v62.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						out.Roles = []string{}
+						v62 = in.Interface()
 					}
-				} else {
-					out.Roles = (out.Roles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v44 string
-					v44 = string(in.String())
-					out.Roles = append(out.Roles, v44)
+					(out.FilterConditions)[key] = v62
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
-		case "owner":
-			out.Owner = bool(in.Bool())
-		case "priority":
-			out.Priority = int(in.Int())
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2349,89 +2788,83 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(out *jwriter.Writer, in Permission) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(out *jwriter.Writer, in queryMessageFlagsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"name\":"
+	if len(in.FilterConditions) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"action\":"
-		out.RawString(prefix)
-		out.String(string(in.Action))
-	}
-	{
-		const prefix string = ",\"resources\":"
-		out.RawString(prefix)
-		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v45, v46 := range in.Resources {
-				if v45 > 0 {
+		{
+			out.RawByte('{')
+			v63First := true
+			for v63Name, v63Value := range in.FilterConditions {
+				if v63First {
+					v63First = false
+				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v46))
+				out.String(string(v63Name))
+				out.RawByte(':')
+				if m, ok := v63Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v63Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v63Value))
+				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	{
-		const prefix string = ",\"roles\":"
-		out.RawString(prefix)
-		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v47, v48 := range in.Roles {
-				if v47 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v48))
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.Int(int(in.Limit))
 	}
-	{
-		const prefix string = ",\"owner\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Owner))
-	}
-	{
-		const prefix string = ",\"priority\":"
-		out.RawString(prefix)
-		out.Int(int(in.Priority))
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Permission) MarshalJSON() ([]byte, error) {
+func (v queryMessageFlagsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Permission) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(w, v)
+func (v queryMessageFlagsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Permission) UnmarshalJSON(data []byte) error {
+func (v *queryMessageFlagsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Permission) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(l, v)
+func (v *queryMessageFlagsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, out *Mute) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(in *jlexer.Lexer, out *queryMembersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2450,17 +2883,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "User":
-			(out.User).UnmarshalEasyJSON(in)
-		case "Target":
-			(out.Target).UnmarshalEasyJSON(in)
-		case "CreatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "UpdatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v64 = nil
+					} else {
+						if v64 == nil {
+							v64 = new(ChannelMember)
+						}
+						(*v64).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2472,57 +2924,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(out *jwriter.Writer, in Mute) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(out *jwriter.Writer, in queryMembersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"User\":"
+		const prefix string = ",\"members\":"
 		out.RawString(prefix[1:])
-		(in.User).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Target\":"
-		out.RawString(prefix)
-		(in.Target).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"CreatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"UpdatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.Members {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				if v66 == nil {
+					out.RawString("null")
+				} else {
+					(*v66).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Mute) MarshalJSON() ([]byte, error) {
+func (v queryMembersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Mute) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(w, v)
+func (v queryMembersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Mute) UnmarshalJSON(data []byte) error {
+func (v *queryMembersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Mute) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(l, v)
+func (v *queryMembersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, out *Message) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, out *queryMembersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2541,208 +2993,71 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "type":
+			out.Type = string(in.String())
 		case "id":
 			out.ID = string(in.String())
-		case "text":
-			out.Text = string(in.String())
-		case "html":
-			out.HTML = string(in.String())
-		case "type":
-			out.Type = MessageType(in.String())
-		case "user":
-			if in.IsNull() {
-				in.Skip()
-				out.User = nil
-			} else {
-				if out.User == nil {
-					out.User = new(User)
-				}
-				(*out.User).UnmarshalEasyJSON(in)
-			}
-		case "attachments":
-			if in.IsNull() {
-				in.Skip()
-				out.Attachments = nil
-			} else {
-				in.Delim('[')
-				if out.Attachments == nil {
-					if !in.IsDelim(']') {
-						out.Attachments = make([]*Attachment, 0, 8)
-					} else {
-						out.Attachments = []*Attachment{}
-					}
-				} else {
-					out.Attachments = (out.Attachments)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v49 *Attachment
-					if in.IsNull() {
-						in.Skip()
-						v49 = nil
-					} else {
-						if v49 == nil {
-							v49 = new(Attachment)
-						}
-						(*v49).UnmarshalEasyJSON(in)
-					}
-					out.Attachments = append(out.Attachments, v49)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "latest_reactions":
-			if in.IsNull() {
-				in.Skip()
-				out.LatestReactions = nil
-			} else {
-				in.Delim('[')
-				if out.LatestReactions == nil {
-					if !in.IsDelim(']') {
-						out.LatestReactions = make([]*Reaction, 0, 8)
-					} else {
-						out.LatestReactions = []*Reaction{}
-					}
-				} else {
-					out.LatestReactions = (out.LatestReactions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v50 *Reaction
-					if in.IsNull() {
-						in.Skip()
-						v50 = nil
-					} else {
-						if v50 == nil {
-							v50 = new(Reaction)
-						}
-						(*v50).UnmarshalEasyJSON(in)
-					}
-					out.LatestReactions = append(out.LatestReactions, v50)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "own_reactions":
-			if in.IsNull() {
-				in.Skip()
-				out.OwnReactions = nil
-			} else {
-				in.Delim('[')
-				if out.OwnReactions == nil {
-					if !in.IsDelim(']') {
-						out.OwnReactions = make([]*Reaction, 0, 8)
-					} else {
-						out.OwnReactions = []*Reaction{}
-					}
-				} else {
-					out.OwnReactions = (out.OwnReactions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v51 *Reaction
-					if in.IsNull() {
-						in.Skip()
-						v51 = nil
-					} else {
-						if v51 == nil {
-							v51 = new(Reaction)
-						}
-						(*v51).UnmarshalEasyJSON(in)
-					}
-					out.OwnReactions = append(out.OwnReactions, v51)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "reaction_counts":
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				in.Delim('{')
 				if !in.IsDelim('}') {
-					out.ReactionCounts = make(map[string]int)
+					out.FilterConditions = make(map[string]interface{})
 				} else {
-					out.ReactionCounts = nil
+					out.FilterConditions = nil
 				}
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v52 int
-					v52 = int(in.Int())
-					(out.ReactionCounts)[key] = v52
+					var v67 interface{}
+					if m, ok := v67.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v67.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v67 = in.Interface()
+					}
+					(out.FilterConditions)[key] = v67
 					in.WantComma()
 				}
 				in.Delim('}')
 			}
-		case "parent_id":
-			out.ParentID = string(in.String())
-		case "show_in_channel":
-			out.ShowInChannel = bool(in.Bool())
-		case "reply_count":
-			out.ReplyCount = int(in.Int())
-		case "mentioned_users":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
-				out.MentionedUsers = nil
+				out.Sort = nil
 			} else {
 				in.Delim('[')
-				if out.MentionedUsers == nil {
+				if out.Sort == nil {
 					if !in.IsDelim(']') {
-						out.MentionedUsers = make([]*User, 0, 8)
+						out.Sort = make([]*SortOption, 0, 8)
 					} else {
-						out.MentionedUsers = []*User{}
+						out.Sort = []*SortOption{}
 					}
 				} else {
-					out.MentionedUsers = (out.MentionedUsers)[:0]
+					out.Sort = (out.Sort)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v53 *User
+					var v68 *SortOption
 					if in.IsNull() {
 						in.Skip()
-						v53 = nil
+						v68 = nil
 					} else {
-						if v53 == nil {
-							v53 = new(User)
+						if v68 == nil {
+							v68 = new(SortOption)
 						}
-						(*v53).UnmarshalEasyJSON(in)
+						(*v68).UnmarshalEasyJSON(in)
 					}
-					out.MentionedUsers = append(out.MentionedUsers, v53)
+					out.Sort = append(out.Sort, v68)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "ExtraData":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.ExtraData = make(map[string]interface{})
-				} else {
-					out.ExtraData = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v54 interface{}
-					if m, ok := v54.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v54.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v54 = in.Interface()
-					}
-					(out.ExtraData)[key] = v54
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2753,219 +3068,10601 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(out *jwriter.Writer, in Message) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(out *jwriter.Writer, in queryMembersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"html\":"
-		out.RawString(prefix)
-		out.String(string(in.HTML))
-	}
 	{
 		const prefix string = ",\"type\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		if in.User == nil {
-			out.RawString("null")
-		} else {
-			(*in.User).MarshalEasyJSON(out)
-		}
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"attachments\":"
+		const prefix string = ",\"filter_conditions\":"
 		out.RawString(prefix)
-		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		if in.FilterConditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawByte('[')
-			for v55, v56 := range in.Attachments {
-				if v55 > 0 {
+			out.RawByte('{')
+			v69First := true
+			for v69Name, v69Value := range in.FilterConditions {
+				if v69First {
+					v69First = false
+				} else {
 					out.RawByte(',')
 				}
-				if v56 == nil {
-					out.RawString("null")
+				out.String(string(v69Name))
+				out.RawByte(':')
+				if m, ok := v69Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v69Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
 				} else {
-					(*v56).MarshalEasyJSON(out)
+					out.Raw(json.Marshal(v69Value))
 				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	{
-		const prefix string = ",\"latest_reactions\":"
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
 		out.RawString(prefix)
-		if in.LatestReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		{
 			out.RawByte('[')
-			for v57, v58 := range in.LatestReactions {
-				if v57 > 0 {
+			for v70, v71 := range in.Sort {
+				if v70 > 0 {
 					out.RawByte(',')
 				}
-				if v58 == nil {
+				if v71 == nil {
 					out.RawString("null")
 				} else {
-					(*v58).MarshalEasyJSON(out)
+					(*v71).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryMembersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryMembersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryMembersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryMembersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, out *queryFlagReportsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag_reports":
+			if in.IsNull() {
+				in.Skip()
+				out.FlagReports = nil
+			} else {
+				in.Delim('[')
+				if out.FlagReports == nil {
+					if !in.IsDelim(']') {
+						out.FlagReports = make([]*FlagReport, 0, 8)
+					} else {
+						out.FlagReports = []*FlagReport{}
+					}
+				} else {
+					out.FlagReports = (out.FlagReports)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 *FlagReport
+					if in.IsNull() {
+						in.Skip()
+						v72 = nil
+					} else {
+						if v72 == nil {
+							v72 = new(FlagReport)
+						}
+						(*v72).UnmarshalEasyJSON(in)
+					}
+					out.FlagReports = append(out.FlagReports, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(out *jwriter.Writer, in queryFlagReportsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag_reports\":"
+		out.RawString(prefix[1:])
+		if in.FlagReports == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v73, v74 := range in.FlagReports {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				if v74 == nil {
+					out.RawString("null")
+				} else {
+					(*v74).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryFlagReportsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryFlagReportsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryFlagReportsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryFlagReportsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, out *queryChannelsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]queryResponse, 0, 0)
+					} else {
+						out.Channels = []queryResponse{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 queryResponse
+					(v75).UnmarshalEasyJSON(in)
+					out.Channels = append(out.Channels, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(out *jwriter.Writer, in queryChannelsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v76, v77 := range in.Channels {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				(v77).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryChannelsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryChannelsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryChannelsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryChannelsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, out *queryChannelsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "watch":
+			out.Watch = bool(in.Bool())
+		case "state":
+			out.State = bool(in.Bool())
+		case "presence":
+			out.Presence = bool(in.Bool())
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
+				} else {
+					out.FilterConditions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v78 interface{}
+					if m, ok := v78.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v78.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v78 = in.Interface()
+					}
+					(out.FilterConditions)[key] = v78
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v79 = nil
+					} else {
+						if v79 == nil {
+							v79 = new(SortOption)
+						}
+						(*v79).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		case "message_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.MessageLimit = nil
+			} else {
+				if out.MessageLimit == nil {
+					out.MessageLimit = new(int)
+				}
+				*out.MessageLimit = int(in.Int())
+			}
+		case "member_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.MemberLimit = nil
+			} else {
+				if out.MemberLimit == nil {
+					out.MemberLimit = new(int)
+				}
+				*out.MemberLimit = int(in.Int())
+			}
+		case "watcher_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.WatcherLimit = nil
+			} else {
+				if out.WatcherLimit == nil {
+					out.WatcherLimit = new(int)
+				}
+				*out.WatcherLimit = int(in.Int())
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(out *jwriter.Writer, in queryChannelsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"watch\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Watch))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.State))
+	}
+	{
+		const prefix string = ",\"presence\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Presence))
+	}
+	if len(in.FilterConditions) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v80First := true
+			for v80Name, v80Value := range in.FilterConditions {
+				if v80First {
+					v80First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v80Name))
+				out.RawByte(':')
+				if m, ok := v80Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v80Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v80Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v81, v82 := range in.Sort {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				if v82 == nil {
+					out.RawString("null")
+				} else {
+					(*v82).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.MessageLimit != nil {
+		const prefix string = ",\"message_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.MessageLimit))
+	}
+	if in.MemberLimit != nil {
+		const prefix string = ",\"member_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.MemberLimit))
+	}
+	if in.WatcherLimit != nil {
+		const prefix string = ",\"watcher_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.WatcherLimit))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryChannelsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryChannelsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryChannelsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryChannelsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, out *queryBannedUsersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bans":
+			if in.IsNull() {
+				in.Skip()
+				out.Bans = nil
+			} else {
+				in.Delim('[')
+				if out.Bans == nil {
+					if !in.IsDelim(']') {
+						out.Bans = make([]*Ban, 0, 8)
+					} else {
+						out.Bans = []*Ban{}
+					}
+				} else {
+					out.Bans = (out.Bans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v83 *Ban
+					if in.IsNull() {
+						in.Skip()
+						v83 = nil
+					} else {
+						if v83 == nil {
+							v83 = new(Ban)
+						}
+						(*v83).UnmarshalEasyJSON(in)
+					}
+					out.Bans = append(out.Bans, v83)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(out *jwriter.Writer, in queryBannedUsersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bans\":"
+		out.RawString(prefix[1:])
+		if in.Bans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v84, v85 := range in.Bans {
+				if v84 > 0 {
+					out.RawByte(',')
+				}
+				if v85 == nil {
+					out.RawString("null")
+				} else {
+					(*v85).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryBannedUsersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryBannedUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryBannedUsersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryBannedUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, out *queryBannedUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
+				} else {
+					out.FilterConditions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v86 interface{}
+					if m, ok := v86.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v86.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v86 = in.Interface()
+					}
+					(out.FilterConditions)[key] = v86
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v87 = nil
+					} else {
+						if v87 == nil {
+							v87 = new(SortOption)
+						}
+						(*v87).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(out *jwriter.Writer, in queryBannedUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.FilterConditions) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v88First := true
+			for v88Name, v88Value := range in.FilterConditions {
+				if v88First {
+					v88First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v88Name))
+				out.RawByte(':')
+				if m, ok := v88Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v88Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v88Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v89, v90 := range in.Sort {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				if v90 == nil {
+					out.RawString("null")
+				} else {
+					(*v90).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryBannedUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryBannedUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryBannedUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryBannedUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(in *jlexer.Lexer, out *pinnedMessagesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 *Message
+					if in.IsNull() {
+						in.Skip()
+						v91 = nil
+					} else {
+						if v91 == nil {
+							v91 = new(Message)
+						}
+						(*v91).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(out *jwriter.Writer, in pinnedMessagesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Messages {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				if v93 == nil {
+					out.RawString("null")
+				} else {
+					(*v93).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pinnedMessagesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pinnedMessagesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pinnedMessagesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pinnedMessagesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(in *jlexer.Lexer, out *pinnedMessagesRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int(in.Int())
+		case "pinned_at_before":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAtBefore = nil
+			} else {
+				if out.PinnedAtBefore == nil {
+					out.PinnedAtBefore = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAtBefore).UnmarshalJSON(data))
+				}
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v94 = nil
+					} else {
+						if v94 == nil {
+							v94 = new(SortOption)
+						}
+						(*v94).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(out *jwriter.Writer, in pinnedMessagesRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	if in.PinnedAtBefore != nil {
+		const prefix string = ",\"pinned_at_before\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PinnedAtBefore).MarshalJSON())
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v95, v96 := range in.Sort {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				if v96 == nil {
+					out.RawString("null")
+				} else {
+					(*v96).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pinnedMessagesRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pinnedMessagesRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pinnedMessagesRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pinnedMessagesRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(in *jlexer.Lexer, out *pendingMessagesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 *Message
+					if in.IsNull() {
+						in.Skip()
+						v97 = nil
+					} else {
+						if v97 == nil {
+							v97 = new(Message)
+						}
+						(*v97).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(out *jwriter.Writer, in pendingMessagesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v98, v99 := range in.Messages {
+				if v98 > 0 {
+					out.RawByte(',')
+				}
+				if v99 == nil {
+					out.RawString("null")
+				} else {
+					(*v99).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pendingMessagesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pendingMessagesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pendingMessagesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pendingMessagesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(in *jlexer.Lexer, out *partialUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]PartialUserUpdate, 0, 1)
+					} else {
+						out.Users = []PartialUserUpdate{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 PartialUserUpdate
+					(v100).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(out *jwriter.Writer, in partialUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix[1:])
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Users {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v partialUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v partialUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *partialUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *partialUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(in *jlexer.Lexer, out *partialMessageRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v103 interface{}
+					if m, ok := v103.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v103.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v103 = in.Interface()
+					}
+					(out.Set)[key] = v103
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v104 string
+					v104 = string(in.String())
+					out.Unset = append(out.Unset, v104)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(out *jwriter.Writer, in partialMessageRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v105First := true
+			for v105Name, v105Value := range in.Set {
+				if v105First {
+					v105First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v105Name))
+				out.RawByte(':')
+				if m, ok := v105Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v105Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v105Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v106, v107 := range in.Unset {
+				if v106 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v107))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v partialMessageRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v partialMessageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *partialMessageRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *partialMessageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(in *jlexer.Lexer, out *muteResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mute":
+			if in.IsNull() {
+				in.Skip()
+				out.Mute = nil
+			} else {
+				if out.Mute == nil {
+					out.Mute = new(Mute)
+				}
+				(*out.Mute).UnmarshalEasyJSON(in)
+			}
+		case "mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.Mutes = nil
+			} else {
+				in.Delim('[')
+				if out.Mutes == nil {
+					if !in.IsDelim(']') {
+						out.Mutes = make([]*Mute, 0, 8)
+					} else {
+						out.Mutes = []*Mute{}
+					}
+				} else {
+					out.Mutes = (out.Mutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v108 *Mute
+					if in.IsNull() {
+						in.Skip()
+						v108 = nil
+					} else {
+						if v108 == nil {
+							v108 = new(Mute)
+						}
+						(*v108).UnmarshalEasyJSON(in)
+					}
+					out.Mutes = append(out.Mutes, v108)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(out *jwriter.Writer, in muteResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mute\":"
+		out.RawString(prefix[1:])
+		if in.Mute == nil {
+			out.RawString("null")
+		} else {
+			(*in.Mute).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		if in.Mutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v109, v110 := range in.Mutes {
+				if v109 > 0 {
+					out.RawByte(',')
+				}
+				if v110 == nil {
+					out.RawString("null")
+				} else {
+					(*v110).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v muteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v muteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *muteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *muteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(in *jlexer.Lexer, out *messageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(out *jwriter.Writer, in messageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(in *jlexer.Lexer, out *messageRequestUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(out *jwriter.Writer, in messageRequestUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequestUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequestUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequestUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequestUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(in *jlexer.Lexer, out *messageRequestMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v111 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v111 = nil
+					} else {
+						if v111 == nil {
+							v111 = new(Attachment)
+						}
+						(*v111).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v111)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(messageRequestUser)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "mentioned_users":
+			if in.IsNull() {
+				in.Skip()
+				out.MentionedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.MentionedUsers == nil {
+					if !in.IsDelim(']') {
+						out.MentionedUsers = make([]string, 0, 4)
+					} else {
+						out.MentionedUsers = []string{}
+					}
+				} else {
+					out.MentionedUsers = (out.MentionedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v112 string
+					v112 = string(in.String())
+					out.MentionedUsers = append(out.MentionedUsers, v112)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parent_id":
+			out.ParentID = string(in.String())
+		case "show_in_channel":
+			out.ShowInChannel = bool(in.Bool())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(out *jwriter.Writer, in messageRequestMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v113, v114 := range in.Attachments {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				if v114 == nil {
+					out.RawString("null")
+				} else {
+					(*v114).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"mentioned_users\":"
+		out.RawString(prefix)
+		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v115, v116 := range in.MentionedUsers {
+				if v115 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v116))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentID))
+	}
+	{
+		const prefix string = ",\"show_in_channel\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowInChannel))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "text", "attachments", "user", "mentioned_users", "parent_id", "show_in_channel":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequestMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequestMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequestMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequestMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(in *jlexer.Lexer, out *messageRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			(out.Message).UnmarshalEasyJSON(in)
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
+		case "skip_enrich_url":
+			out.SkipEnrichURL = bool(in.Bool())
+		case "force_moderation":
+			out.ForceModeration = bool(in.Bool())
+		case "pending":
+			out.Pending = bool(in.Bool())
+		case "pending_message_metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.PendingMessageMetadata = make(map[string]string)
+				} else {
+					out.PendingMessageMetadata = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v117 string
+					v117 = string(in.String())
+					(out.PendingMessageMetadata)[key] = v117
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(out *jwriter.Writer, in messageRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		(in.Message).MarshalEasyJSON(out)
+	}
+	if in.SkipPush {
+		const prefix string = ",\"skip_push\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
+	if in.SkipEnrichURL {
+		const prefix string = ",\"skip_enrich_url\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipEnrichURL))
+	}
+	if in.ForceModeration {
+		const prefix string = ",\"force_moderation\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ForceModeration))
+	}
+	if in.Pending {
+		const prefix string = ",\"pending\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Pending))
+	}
+	if len(in.PendingMessageMetadata) != 0 {
+		const prefix string = ",\"pending_message_metadata\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v118First := true
+			for v118Name, v118Value := range in.PendingMessageMetadata {
+				if v118First {
+					v118First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v118Name))
+				out.RawByte(':')
+				out.String(string(v118Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(in *jlexer.Lexer, out *listBlocklistsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocklists = nil
+			} else {
+				in.Delim('[')
+				if out.Blocklists == nil {
+					if !in.IsDelim(']') {
+						out.Blocklists = make([]*Blocklist, 0, 8)
+					} else {
+						out.Blocklists = []*Blocklist{}
+					}
+				} else {
+					out.Blocklists = (out.Blocklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v119 *Blocklist
+					if in.IsNull() {
+						in.Skip()
+						v119 = nil
+					} else {
+						if v119 == nil {
+							v119 = new(Blocklist)
+						}
+						(*v119).UnmarshalEasyJSON(in)
+					}
+					out.Blocklists = append(out.Blocklists, v119)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(out *jwriter.Writer, in listBlocklistsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocklists\":"
+		out.RawString(prefix[1:])
+		if in.Blocklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v120, v121 := range in.Blocklists {
+				if v120 > 0 {
+					out.RawByte(',')
+				}
+				if v121 == nil {
+					out.RawString("null")
+				} else {
+					(*v121).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v listBlocklistsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v listBlocklistsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *listBlocklistsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *listBlocklistsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(in *jlexer.Lexer, out *flagReportResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag_report":
+			if in.IsNull() {
+				in.Skip()
+				out.FlagReport = nil
+			} else {
+				if out.FlagReport == nil {
+					out.FlagReport = new(FlagReport)
+				}
+				(*out.FlagReport).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(out *jwriter.Writer, in flagReportResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag_report\":"
+		out.RawString(prefix[1:])
+		if in.FlagReport == nil {
+			out.RawString("null")
+		} else {
+			(*in.FlagReport).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v flagReportResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v flagReportResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *flagReportResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *flagReportResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(in *jlexer.Lexer, out *exportUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v122 string
+					v122 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v122)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(out *jwriter.Writer, in exportUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v123, v124 := range in.UserIDs {
+				if v123 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v124))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v exportUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v exportUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *exportUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *exportUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(in *jlexer.Lexer, out *exportChannelsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]*ExportChannelRequest, 0, 8)
+					} else {
+						out.Channels = []*ExportChannelRequest{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v125 *ExportChannelRequest
+					if in.IsNull() {
+						in.Skip()
+						v125 = nil
+					} else {
+						if v125 == nil {
+							v125 = new(ExportChannelRequest)
+						}
+						(*v125).UnmarshalEasyJSON(in)
+					}
+					out.Channels = append(out.Channels, v125)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "clear_deleted_message_text":
+			out.ClearDeletedMessageText = bool(in.Bool())
+		case "include_truncated_messages":
+			out.IncludeTruncatedMessages = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(out *jwriter.Writer, in exportChannelsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v126, v127 := range in.Channels {
+				if v126 > 0 {
+					out.RawByte(',')
+				}
+				if v127 == nil {
+					out.RawString("null")
+				} else {
+					(*v127).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ClearDeletedMessageText {
+		const prefix string = ",\"clear_deleted_message_text\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClearDeletedMessageText))
+	}
+	if in.IncludeTruncatedMessages {
+		const prefix string = ",\"include_truncated_messages\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IncludeTruncatedMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v exportChannelsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v exportChannelsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *exportChannelsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *exportChannelsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(in *jlexer.Lexer, out *eventRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event":
+			if in.IsNull() {
+				in.Skip()
+				out.Event = nil
+			} else {
+				if out.Event == nil {
+					out.Event = new(Event)
+				}
+				(*out.Event).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(out *jwriter.Writer, in eventRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix[1:])
+		if in.Event == nil {
+			out.RawString("null")
+		} else {
+			(*in.Event).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v eventRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v eventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *eventRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *eventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(in *jlexer.Lexer, out *devicesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "devices":
+			if in.IsNull() {
+				in.Skip()
+				out.Devices = nil
+			} else {
+				in.Delim('[')
+				if out.Devices == nil {
+					if !in.IsDelim(']') {
+						out.Devices = make([]*Device, 0, 8)
+					} else {
+						out.Devices = []*Device{}
+					}
+				} else {
+					out.Devices = (out.Devices)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v128 *Device
+					if in.IsNull() {
+						in.Skip()
+						v128 = nil
+					} else {
+						if v128 == nil {
+							v128 = new(Device)
+						}
+						(*v128).UnmarshalEasyJSON(in)
+					}
+					out.Devices = append(out.Devices, v128)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(out *jwriter.Writer, in devicesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"devices\":"
+		out.RawString(prefix[1:])
+		if in.Devices == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v129, v130 := range in.Devices {
+				if v129 > 0 {
+					out.RawByte(',')
+				}
+				if v130 == nil {
+					out.RawString("null")
+				} else {
+					(*v130).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v devicesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v devicesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *devicesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *devicesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(in *jlexer.Lexer, out *deleteUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v131 string
+					v131 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v131)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user":
+			out.User = DeleteType(in.String())
+		case "messages":
+			out.Messages = DeleteType(in.String())
+		case "conversations":
+			out.Conversations = DeleteType(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(out *jwriter.Writer, in deleteUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v132, v133 := range in.UserIDs {
+				if v132 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v133))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.String(string(in.User))
+	}
+	if in.Messages != "" {
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		out.String(string(in.Messages))
+	}
+	if in.Conversations != "" {
+		const prefix string = ",\"conversations\":"
+		out.RawString(prefix)
+		out.String(string(in.Conversations))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v deleteUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v deleteUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *deleteUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *deleteUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(in *jlexer.Lexer, out *checkSQSRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sqs_url":
+			out.SQSURL = string(in.String())
+		case "sqs_key":
+			out.SQSKey = string(in.String())
+		case "sqs_secret":
+			out.SQSSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(out *jwriter.Writer, in checkSQSRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sqs_url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SQSURL))
+	}
+	{
+		const prefix string = ",\"sqs_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSKey))
+	}
+	{
+		const prefix string = ",\"sqs_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v checkSQSRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v checkSQSRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *checkSQSRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *checkSQSRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(in *jlexer.Lexer, out *checkSNSRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sns_topic_arn":
+			out.SNSTopicARN = string(in.String())
+		case "sns_key":
+			out.SNSKey = string(in.String())
+		case "sns_secret":
+			out.SNSSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(out *jwriter.Writer, in checkSNSRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sns_topic_arn\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SNSTopicARN))
+	}
+	{
+		const prefix string = ",\"sns_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSKey))
+	}
+	{
+		const prefix string = ",\"sns_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v checkSNSRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v checkSNSRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *checkSNSRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *checkSNSRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(in *jlexer.Lexer, out *channelTypeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_types":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ChannelTypes = make(map[string]*ChannelType)
+				} else {
+					out.ChannelTypes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v134 *ChannelType
+					if in.IsNull() {
+						in.Skip()
+						v134 = nil
+					} else {
+						if v134 == nil {
+							v134 = new(ChannelType)
+						}
+						(*v134).UnmarshalEasyJSON(in)
+					}
+					(out.ChannelTypes)[key] = v134
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(out *jwriter.Writer, in channelTypeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_types\":"
+		out.RawString(prefix[1:])
+		if in.ChannelTypes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v135First := true
+			for v135Name, v135Value := range in.ChannelTypes {
+				if v135First {
+					v135First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v135Name))
+				out.RawByte(':')
+				if v135Value == nil {
+					out.RawString("null")
+				} else {
+					(*v135Value).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelTypeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelTypeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelTypeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelTypeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(in *jlexer.Lexer, out *channelTypeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ChannelType = new(ChannelType)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]string, 0, 4)
+					} else {
+						out.Commands = []string{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v136 string
+					v136 = string(in.String())
+					out.Commands = append(out.Commands, v136)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]*Permission, 0, 8)
+					} else {
+						out.Permissions = []*Permission{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v137 *Permission
+					if in.IsNull() {
+						in.Skip()
+						v137 = nil
+					} else {
+						if v137 == nil {
+							v137 = new(Permission)
+						}
+						(*v137).UnmarshalEasyJSON(in)
+					}
+					out.Permissions = append(out.Permissions, v137)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = blocklistBehavior(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer, in channelTypeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v138, v139 := range in.Commands {
+				if v138 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v139))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v140, v141 := range in.Permissions {
+				if v140 > 0 {
+					out.RawByte(',')
+				}
+				if v141 == nil {
+					out.RawString("null")
+				} else {
+					(*v141).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
+	{
+		const prefix string = ",\"connect_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ConnectEvents))
+	}
+	{
+		const prefix string = ",\"search\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Search))
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reactions))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replies))
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutes))
+	}
+	{
+		const prefix string = ",\"message_retention\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageRetention))
+	}
+	{
+		const prefix string = ",\"max_message_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMessageLength))
+	}
+	{
+		const prefix string = ",\"automod\":"
+		out.RawString(prefix)
+		out.String(string(in.Automod))
+	}
+	{
+		const prefix string = ",\"automod_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelTypeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelTypeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelTypeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelTypeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(in *jlexer.Lexer, out *channelMemberResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_member":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMember = nil
+			} else {
+				if out.ChannelMember == nil {
+					out.ChannelMember = new(ChannelMember)
+				}
+				(*out.ChannelMember).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(out *jwriter.Writer, in channelMemberResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_member\":"
+		out.RawString(prefix[1:])
+		if in.ChannelMember == nil {
+			out.RawString("null")
+		} else {
+			(*in.ChannelMember).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelMemberResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelMemberResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelMemberResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelMemberResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, out *blocklistResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocklist":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocklist = nil
+			} else {
+				if out.Blocklist == nil {
+					out.Blocklist = new(Blocklist)
+				}
+				(*out.Blocklist).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(out *jwriter.Writer, in blocklistResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix[1:])
+		if in.Blocklist == nil {
+			out.RawString("null")
+		} else {
+			(*in.Blocklist).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blocklistResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blocklistResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blocklistResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blocklistResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(in *jlexer.Lexer, out *blockedUsersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocks":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocks = nil
+			} else {
+				in.Delim('[')
+				if out.Blocks == nil {
+					if !in.IsDelim(']') {
+						out.Blocks = make([]*BlockedUser, 0, 8)
+					} else {
+						out.Blocks = []*BlockedUser{}
+					}
+				} else {
+					out.Blocks = (out.Blocks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v142 *BlockedUser
+					if in.IsNull() {
+						in.Skip()
+						v142 = nil
+					} else {
+						if v142 == nil {
+							v142 = new(BlockedUser)
+						}
+						(*v142).UnmarshalEasyJSON(in)
+					}
+					out.Blocks = append(out.Blocks, v142)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(out *jwriter.Writer, in blockedUsersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocks\":"
+		out.RawString(prefix[1:])
+		if in.Blocks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v143, v144 := range in.Blocks {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				if v144 == nil {
+					out.RawString("null")
+				} else {
+					(*v144).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blockedUsersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blockedUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blockedUsersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blockedUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo54(in *jlexer.Lexer, out *appResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "app":
+			if in.IsNull() {
+				in.Skip()
+				out.App = nil
+			} else {
+				if out.App == nil {
+					out.App = new(AppSettings)
+				}
+				(*out.App).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo54(out *jwriter.Writer, in appResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"app\":"
+		out.RawString(prefix[1:])
+		if in.App == nil {
+			out.RawString("null")
+		} else {
+			(*in.App).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v appResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v appResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *appResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *appResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo54(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo55(in *jlexer.Lexer, out *WebhookPipeline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo55(out *jwriter.Writer, in WebhookPipeline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WebhookPipeline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WebhookPipeline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebhookPipeline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebhookPipeline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo55(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo56(in *jlexer.Lexer, out *UserCustomEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo56(out *jwriter.Writer, in UserCustomEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "type", "created_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserCustomEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserCustomEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserCustomEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserCustomEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo56(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo57(in *jlexer.Lexer, out *User) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "online":
+			out.Online = bool(in.Bool())
+		case "invisible":
+			out.Invisible = bool(in.Bool())
+		case "teams":
+			if in.IsNull() {
+				in.Skip()
+				out.Teams = nil
+			} else {
+				in.Delim('[')
+				if out.Teams == nil {
+					if !in.IsDelim(']') {
+						out.Teams = make([]string, 0, 4)
+					} else {
+						out.Teams = []string{}
+					}
+				} else {
+					out.Teams = (out.Teams)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v145 string
+					v145 = string(in.String())
+					out.Teams = append(out.Teams, v145)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "teams_role":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.TeamsRole = make(map[string]string)
+				} else {
+					out.TeamsRole = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v146 string
+					v146 = string(in.String())
+					(out.TeamsRole)[key] = v146
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.Mutes = nil
+			} else {
+				in.Delim('[')
+				if out.Mutes == nil {
+					if !in.IsDelim(']') {
+						out.Mutes = make([]*Mute, 0, 8)
+					} else {
+						out.Mutes = []*Mute{}
+					}
+				} else {
+					out.Mutes = (out.Mutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v147 *Mute
+					if in.IsNull() {
+						in.Skip()
+						v147 = nil
+					} else {
+						if v147 == nil {
+							v147 = new(Mute)
+						}
+						(*v147).UnmarshalEasyJSON(in)
+					}
+					out.Mutes = append(out.Mutes, v147)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "channel_mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMutes = nil
+			} else {
+				in.Delim('[')
+				if out.ChannelMutes == nil {
+					if !in.IsDelim(']') {
+						out.ChannelMutes = make([]*ChannelMute, 0, 8)
+					} else {
+						out.ChannelMutes = []*ChannelMute{}
+					}
+				} else {
+					out.ChannelMutes = (out.ChannelMutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v148 *ChannelMute
+					if in.IsNull() {
+						in.Skip()
+						v148 = nil
+					} else {
+						if v148 == nil {
+							v148 = new(ChannelMute)
+						}
+						(*v148).UnmarshalEasyJSON(in)
+					}
+					out.ChannelMutes = append(out.ChannelMutes, v148)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "last_active":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastActive).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo57(out *jwriter.Writer, in User) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	{
+		const prefix string = ",\"invisible\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Invisible))
+	}
+	if len(in.Teams) != 0 {
+		const prefix string = ",\"teams\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v149, v150 := range in.Teams {
+				if v149 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v150))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TeamsRole) != 0 {
+		const prefix string = ",\"teams_role\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v151First := true
+			for v151Name, v151Value := range in.TeamsRole {
+				if v151First {
+					v151First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v151Name))
+				out.RawByte(':')
+				out.String(string(v151Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		if in.Mutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v152, v153 := range in.Mutes {
+				if v152 > 0 {
+					out.RawByte(',')
+				}
+				if v153 == nil {
+					out.RawString("null")
+				} else {
+					(*v153).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"channel_mutes\":"
+		out.RawString(prefix)
+		if in.ChannelMutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v154, v155 := range in.ChannelMutes {
+				if v154 > 0 {
+					out.RawByte(',')
+				}
+				if v155 == nil {
+					out.RawString("null")
+				} else {
+					(*v155).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_active\":"
+		out.RawString(prefix)
+		out.Raw((in.LastActive).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "name", "image", "role", "online", "invisible", "teams", "teams_role", "mutes", "channel_mutes", "created_at", "updated_at", "last_active":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v User) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *User) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo57(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo58(in *jlexer.Lexer, out *TruncateOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "HardDelete":
+			out.HardDelete = bool(in.Bool())
+		case "SkipPush":
+			out.SkipPush = bool(in.Bool())
+		case "TruncatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TruncatedAt).UnmarshalJSON(data))
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo58(out *jwriter.Writer, in TruncateOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"HardDelete\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HardDelete))
+	}
+	{
+		const prefix string = ",\"SkipPush\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
+	{
+		const prefix string = ",\"TruncatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.TruncatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TruncateOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TruncateOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TruncateOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TruncateOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo58(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo59(in *jlexer.Lexer, out *ThrottleProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Concurrency":
+			out.Concurrency = int(in.Int())
+		case "Interval":
+			out.Interval = time.Duration(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo59(out *jwriter.Writer, in ThrottleProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Concurrency\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Concurrency))
+	}
+	{
+		const prefix string = ",\"Interval\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Interval))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThrottleProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ThrottleProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThrottleProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ThrottleProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo59(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo60(in *jlexer.Lexer, out *Thresholds) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "explicit":
+			if in.IsNull() {
+				in.Skip()
+				out.Explicit = nil
+			} else {
+				if out.Explicit == nil {
+					out.Explicit = new(LabelThresholds)
+				}
+				(*out.Explicit).UnmarshalEasyJSON(in)
+			}
+		case "spam":
+			if in.IsNull() {
+				in.Skip()
+				out.Spam = nil
+			} else {
+				if out.Spam == nil {
+					out.Spam = new(LabelThresholds)
+				}
+				(*out.Spam).UnmarshalEasyJSON(in)
+			}
+		case "toxic":
+			if in.IsNull() {
+				in.Skip()
+				out.Toxic = nil
+			} else {
+				if out.Toxic == nil {
+					out.Toxic = new(LabelThresholds)
+				}
+				(*out.Toxic).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo60(out *jwriter.Writer, in Thresholds) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Explicit != nil {
+		const prefix string = ",\"explicit\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Explicit).MarshalEasyJSON(out)
+	}
+	if in.Spam != nil {
+		const prefix string = ",\"spam\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Spam).MarshalEasyJSON(out)
+	}
+	if in.Toxic != nil {
+		const prefix string = ",\"toxic\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Toxic).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Thresholds) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Thresholds) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Thresholds) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Thresholds) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo60(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo61(in *jlexer.Lexer, out *Task) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = string(in.String())
+		case "status":
+			out.Status = TaskStatus(in.String())
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Result = make(map[string]interface{})
+				} else {
+					out.Result = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v156 interface{}
+					if m, ok := v156.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v156.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v156 = in.Interface()
+					}
+					(out.Result)[key] = v156
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo61(out *jwriter.Writer, in Task) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TaskID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		if in.Result == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v157First := true
+			for v157Name, v157Value := range in.Result {
+				if v157First {
+					v157First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v157Name))
+				out.RawByte(':')
+				if m, ok := v157Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v157Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v157Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Task) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Task) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Task) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Task) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo61(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo62(in *jlexer.Lexer, out *TailOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v158 interface{}
+					if m, ok := v158.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v158.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v158 = in.Interface()
+					}
+					(out.Filter)[key] = v158
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Since":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Since).UnmarshalJSON(data))
+			}
+		case "PollInterval":
+			out.PollInterval = time.Duration(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo62(out *jwriter.Writer, in TailOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v159First := true
+			for v159Name, v159Value := range in.Filter {
+				if v159First {
+					v159First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v159Name))
+				out.RawByte(':')
+				if m, ok := v159Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v159Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v159Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Since\":"
+		out.RawString(prefix)
+		out.Raw((in.Since).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PollInterval\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PollInterval))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TailOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TailOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TailOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TailOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo62(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo63(in *jlexer.Lexer, out *SortOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "direction":
+			out.Direction = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo63(out *jwriter.Writer, in SortOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Field))
+	}
+	{
+		const prefix string = ",\"direction\":"
+		out.RawString(prefix)
+		out.Int(int(in.Direction))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SortOption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SortOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SortOption) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SortOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo63(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo64(in *jlexer.Lexer, out *RoleAssignment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "channel_role":
+			out.ChannelRole = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo64(out *jwriter.Writer, in RoleAssignment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"channel_role\":"
+		out.RawString(prefix)
+		out.String(string(in.ChannelRole))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoleAssignment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoleAssignment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoleAssignment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoleAssignment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo64(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo65(in *jlexer.Lexer, out *Reaction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo65(out *jwriter.Writer, in Reaction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "message_id", "user_id", "type":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Reaction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Reaction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Reaction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Reaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo65(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo66(in *jlexer.Lexer, out *RateLimitError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Remaining":
+			out.Remaining = int(in.Int())
+		case "Reset":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Reset).UnmarshalJSON(data))
+			}
+		case "RetryAfter":
+			out.RetryAfter = time.Duration(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo66(out *jwriter.Writer, in RateLimitError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Remaining\":"
+		out.RawString(prefix)
+		out.Int(int(in.Remaining))
+	}
+	{
+		const prefix string = ",\"Reset\":"
+		out.RawString(prefix)
+		out.Raw((in.Reset).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"RetryAfter\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RetryAfter))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RateLimitError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RateLimitError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RateLimitError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RateLimitError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo66(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo67(in *jlexer.Lexer, out *QueryOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v160 interface{}
+					if m, ok := v160.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v160.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v160 = in.Interface()
+					}
+					(out.Filter)[key] = v160
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		case "presence":
+			out.Presence = bool(in.Bool())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "message_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.MessageLimit = nil
+			} else {
+				if out.MessageLimit == nil {
+					out.MessageLimit = new(int)
+				}
+				*out.MessageLimit = int(in.Int())
+			}
+		case "member_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.MemberLimit = nil
+			} else {
+				if out.MemberLimit == nil {
+					out.MemberLimit = new(int)
+				}
+				*out.MemberLimit = int(in.Int())
+			}
+		case "watcher_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.WatcherLimit = nil
+			} else {
+				if out.WatcherLimit == nil {
+					out.WatcherLimit = new(int)
+				}
+				*out.WatcherLimit = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo67(out *jwriter.Writer, in QueryOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Filter) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v161First := true
+			for v161Name, v161Value := range in.Filter {
+				if v161First {
+					v161First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v161Name))
+				out.RawByte(':')
+				if m, ok := v161Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v161Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v161Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
+	}
+	if in.Presence {
+		const prefix string = ",\"presence\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Presence))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserID))
+	}
+	if in.MessageLimit != nil {
+		const prefix string = ",\"message_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(*in.MessageLimit))
+	}
+	if in.MemberLimit != nil {
+		const prefix string = ",\"member_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(*in.MemberLimit))
+	}
+	if in.WatcherLimit != nil {
+		const prefix string = ",\"watcher_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(*in.WatcherLimit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryOption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryOption) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo67(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo68(in *jlexer.Lexer, out *Permission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "resources":
+			if in.IsNull() {
+				in.Skip()
+				out.Resources = nil
+			} else {
+				in.Delim('[')
+				if out.Resources == nil {
+					if !in.IsDelim(']') {
+						out.Resources = make([]string, 0, 4)
+					} else {
+						out.Resources = []string{}
+					}
+				} else {
+					out.Resources = (out.Resources)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v162 string
+					v162 = string(in.String())
+					out.Resources = append(out.Resources, v162)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "roles":
+			if in.IsNull() {
+				in.Skip()
+				out.Roles = nil
+			} else {
+				in.Delim('[')
+				if out.Roles == nil {
+					if !in.IsDelim(']') {
+						out.Roles = make([]string, 0, 4)
+					} else {
+						out.Roles = []string{}
+					}
+				} else {
+					out.Roles = (out.Roles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v163 string
+					v163 = string(in.String())
+					out.Roles = append(out.Roles, v163)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner":
+			out.Owner = bool(in.Bool())
+		case "priority":
+			out.Priority = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo68(out *jwriter.Writer, in Permission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"resources\":"
+		out.RawString(prefix)
+		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.Resources {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v165))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"roles\":"
+		out.RawString(prefix)
+		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v166, v167 := range in.Roles {
+				if v166 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v167))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Owner))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.Int(int(in.Priority))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Permission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Permission) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Permission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Permission) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo68(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo69(in *jlexer.Lexer, out *PartialUserUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v168 interface{}
+					if m, ok := v168.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v168.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v168 = in.Interface()
+					}
+					(out.Set)[key] = v168
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v169 string
+					v169 = string(in.String())
+					out.Unset = append(out.Unset, v169)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo69(out *jwriter.Writer, in PartialUserUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v170First := true
+			for v170Name, v170Value := range in.Set {
+				if v170First {
+					v170First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v170Name))
+				out.RawByte(':')
+				if m, ok := v170Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v170Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v170Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v171, v172 := range in.Unset {
+				if v171 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v172))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PartialUserUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PartialUserUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PartialUserUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PartialUserUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo69(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo70(in *jlexer.Lexer, out *PartialMessageUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v173 interface{}
+					if m, ok := v173.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v173.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v173 = in.Interface()
+					}
+					(out.Set)[key] = v173
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v174 string
+					v174 = string(in.String())
+					out.Unset = append(out.Unset, v174)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo70(out *jwriter.Writer, in PartialMessageUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v175First := true
+			for v175Name, v175Value := range in.Set {
+				if v175First {
+					v175First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v175Name))
+				out.RawByte(':')
+				if m, ok := v175Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v175Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v175Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v176, v177 := range in.Unset {
+				if v176 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v177))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PartialMessageUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PartialMessageUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PartialMessageUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PartialMessageUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo70(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo71(in *jlexer.Lexer, out *Mute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "target":
+			(out.Target).UnmarshalEasyJSON(in)
+		case "expires":
+			if in.IsNull() {
+				in.Skip()
+				out.Expires = nil
+			} else {
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo71(out *jwriter.Writer, in Mute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		(in.Target).MarshalEasyJSON(out)
+	}
+	if in.Expires != nil {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.Expires).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Mute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Mute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Mute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Mute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo71(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo72(in *jlexer.Lexer, out *ModerationPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "texts":
+			if in.IsNull() {
+				in.Skip()
+				out.Texts = nil
+			} else {
+				in.Delim('[')
+				if out.Texts == nil {
+					if !in.IsDelim(']') {
+						out.Texts = make([]string, 0, 4)
+					} else {
+						out.Texts = []string{}
+					}
+				} else {
+					out.Texts = (out.Texts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v178 string
+					v178 = string(in.String())
+					out.Texts = append(out.Texts, v178)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]string, 0, 4)
+					} else {
+						out.Images = []string{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v179 string
+					v179 = string(in.String())
+					out.Images = append(out.Images, v179)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "custom":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Custom = make(map[string]interface{})
+				} else {
+					out.Custom = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v180 interface{}
+					if m, ok := v180.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v180.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v180 = in.Interface()
+					}
+					(out.Custom)[key] = v180
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo72(out *jwriter.Writer, in ModerationPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Texts) != 0 {
+		const prefix string = ",\"texts\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v181, v182 := range in.Texts {
+				if v181 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v182))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v183, v184 := range in.Images {
+				if v183 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v184))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Custom) != 0 {
+		const prefix string = ",\"custom\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v185First := true
+			for v185Name, v185Value := range in.Custom {
+				if v185First {
+					v185First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v185Name))
+				out.RawByte(':')
+				if m, ok := v185Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v185Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v185Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo72(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo73(in *jlexer.Lexer, out *ModerationCheckResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recommended_action":
+			out.RecommendedAction = ModerationAction(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "task_id":
+			out.TaskID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo73(out *jwriter.Writer, in ModerationCheckResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recommended_action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RecommendedAction))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.TaskID != "" {
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TaskID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationCheckResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationCheckResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo73(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo74(in *jlexer.Lexer, out *ModerationCheckRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entity_type":
+			out.EntityType = string(in.String())
+		case "entity_id":
+			out.EntityID = string(in.String())
+		case "entity_creator_id":
+			out.EntityCreatorID = string(in.String())
+		case "moderation_payload":
+			if in.IsNull() {
+				in.Skip()
+				out.Payload = nil
+			} else {
+				if out.Payload == nil {
+					out.Payload = new(ModerationPayload)
+				}
+				(*out.Payload).UnmarshalEasyJSON(in)
+			}
+		case "config_key":
+			out.ConfigKey = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo74(out *jwriter.Writer, in ModerationCheckRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.EntityType))
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityID))
+	}
+	if in.EntityCreatorID != "" {
+		const prefix string = ",\"entity_creator_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityCreatorID))
+	}
+	{
+		const prefix string = ",\"moderation_payload\":"
+		out.RawString(prefix)
+		if in.Payload == nil {
+			out.RawString("null")
+		} else {
+			(*in.Payload).MarshalEasyJSON(out)
+		}
+	}
+	if in.ConfigKey != "" {
+		const prefix string = ",\"config_key\":"
+		out.RawString(prefix)
+		out.String(string(in.ConfigKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationCheckRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationCheckRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationCheckRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationCheckRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo74(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo75(in *jlexer.Lexer, out *MessageFlagResolution) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MessageID":
+			out.MessageID = string(in.String())
+		case "AuthorID":
+			out.AuthorID = string(in.String())
+		case "FlaggedByID":
+			out.FlaggedByID = string(in.String())
+		case "ModeratorID":
+			out.ModeratorID = string(in.String())
+		case "Action":
+			out.Action = FlagResolution(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo75(out *jwriter.Writer, in MessageFlagResolution) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MessageID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"AuthorID\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"FlaggedByID\":"
+		out.RawString(prefix)
+		out.String(string(in.FlaggedByID))
+	}
+	{
+		const prefix string = ",\"ModeratorID\":"
+		out.RawString(prefix)
+		out.String(string(in.ModeratorID))
+	}
+	{
+		const prefix string = ",\"Action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageFlagResolution) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageFlagResolution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageFlagResolution) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageFlagResolution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo75(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo76(in *jlexer.Lexer, out *MessageFlag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created_by_automod":
+			out.CreatedByAutomod = bool(in.Bool())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedBy = nil
+			} else {
+				if out.ReviewedBy == nil {
+					out.ReviewedBy = new(User)
+				}
+				(*out.ReviewedBy).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedAt = nil
+			} else {
+				if out.ReviewedAt == nil {
+					out.ReviewedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReviewedAt).UnmarshalJSON(data))
+				}
+			}
+		case "approved_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ApprovedAt = nil
+			} else {
+				if out.ApprovedAt == nil {
+					out.ApprovedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ApprovedAt).UnmarshalJSON(data))
+				}
+			}
+		case "rejected_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RejectedAt = nil
+			} else {
+				if out.RejectedAt == nil {
+					out.RejectedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RejectedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo76(out *jwriter.Writer, in MessageFlag) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created_by_automod\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.CreatedByAutomod))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	if in.ReviewedBy != nil {
+		const prefix string = ",\"reviewed_by\":"
+		out.RawString(prefix)
+		(*in.ReviewedBy).MarshalEasyJSON(out)
+	}
+	if in.ReviewedAt != nil {
+		const prefix string = ",\"reviewed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReviewedAt).MarshalJSON())
+	}
+	if in.ApprovedAt != nil {
+		const prefix string = ",\"approved_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ApprovedAt).MarshalJSON())
+	}
+	if in.RejectedAt != nil {
+		const prefix string = ",\"rejected_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.RejectedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageFlag) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageFlag) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo76(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo77(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "cid":
+			out.CID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "html":
+			out.HTML = string(in.String())
+		case "type":
+			out.Type = MessageType(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v186 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v186 = nil
+					} else {
+						if v186 == nil {
+							v186 = new(Attachment)
+						}
+						(*v186).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v186)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "latest_reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.LatestReactions = nil
+			} else {
+				in.Delim('[')
+				if out.LatestReactions == nil {
+					if !in.IsDelim(']') {
+						out.LatestReactions = make([]*Reaction, 0, 8)
+					} else {
+						out.LatestReactions = []*Reaction{}
+					}
+				} else {
+					out.LatestReactions = (out.LatestReactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v187 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v187 = nil
+					} else {
+						if v187 == nil {
+							v187 = new(Reaction)
+						}
+						(*v187).UnmarshalEasyJSON(in)
+					}
+					out.LatestReactions = append(out.LatestReactions, v187)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "own_reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnReactions = nil
+			} else {
+				in.Delim('[')
+				if out.OwnReactions == nil {
+					if !in.IsDelim(']') {
+						out.OwnReactions = make([]*Reaction, 0, 8)
+					} else {
+						out.OwnReactions = []*Reaction{}
+					}
+				} else {
+					out.OwnReactions = (out.OwnReactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v188 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v188 = nil
+					} else {
+						if v188 == nil {
+							v188 = new(Reaction)
+						}
+						(*v188).UnmarshalEasyJSON(in)
+					}
+					out.OwnReactions = append(out.OwnReactions, v188)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reaction_counts":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ReactionCounts = make(map[string]int)
+				} else {
+					out.ReactionCounts = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v189 int
+					v189 = int(in.Int())
+					(out.ReactionCounts)[key] = v189
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "parent_id":
+			out.ParentID = string(in.String())
+		case "show_in_channel":
+			out.ShowInChannel = bool(in.Bool())
+		case "reply_count":
+			out.ReplyCount = int(in.Int())
+		case "mentioned_users":
+			if in.IsNull() {
+				in.Skip()
+				out.MentionedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.MentionedUsers == nil {
+					if !in.IsDelim(']') {
+						out.MentionedUsers = make([]*User, 0, 8)
+					} else {
+						out.MentionedUsers = []*User{}
+					}
+				} else {
+					out.MentionedUsers = (out.MentionedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v190 *User
+					if in.IsNull() {
+						in.Skip()
+						v190 = nil
+					} else {
+						if v190 == nil {
+							v190 = new(User)
+						}
+						(*v190).UnmarshalEasyJSON(in)
+					}
+					out.MentionedUsers = append(out.MentionedUsers, v190)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pinned":
+			out.Pinned = bool(in.Bool())
+		case "pinned_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAt = nil
+			} else {
+				if out.PinnedAt == nil {
+					out.PinnedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAt).UnmarshalJSON(data))
+				}
+			}
+		case "pinned_by":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedBy = nil
+			} else {
+				if out.PinnedBy == nil {
+					out.PinnedBy = new(User)
+				}
+				(*out.PinnedBy).UnmarshalEasyJSON(in)
+			}
+		case "pin_expires":
+			if in.IsNull() {
+				in.Skip()
+				out.PinExpires = nil
+			} else {
+				if out.PinExpires == nil {
+					out.PinExpires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinExpires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo77(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.CID != "" {
+		const prefix string = ",\"cid\":"
+		out.RawString(prefix)
+		out.String(string(in.CID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"html\":"
+		out.RawString(prefix)
+		out.String(string(in.HTML))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v191, v192 := range in.Attachments {
+				if v191 > 0 {
+					out.RawByte(',')
+				}
+				if v192 == nil {
+					out.RawString("null")
+				} else {
+					(*v192).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"latest_reactions\":"
+		out.RawString(prefix)
+		if in.LatestReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v193, v194 := range in.LatestReactions {
+				if v193 > 0 {
+					out.RawByte(',')
+				}
+				if v194 == nil {
+					out.RawString("null")
+				} else {
+					(*v194).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"own_reactions\":"
+		out.RawString(prefix)
+		if in.OwnReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v195, v196 := range in.OwnReactions {
+				if v195 > 0 {
+					out.RawByte(',')
+				}
+				if v196 == nil {
+					out.RawString("null")
+				} else {
+					(*v196).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reaction_counts\":"
+		out.RawString(prefix)
+		if in.ReactionCounts == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v197First := true
+			for v197Name, v197Value := range in.ReactionCounts {
+				if v197First {
+					v197First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v197Name))
+				out.RawByte(':')
+				out.Int(int(v197Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentID))
+	}
+	{
+		const prefix string = ",\"show_in_channel\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowInChannel))
+	}
+	{
+		const prefix string = ",\"reply_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyCount))
+	}
+	{
+		const prefix string = ",\"mentioned_users\":"
+		out.RawString(prefix)
+		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v198, v199 := range in.MentionedUsers {
+				if v198 > 0 {
+					out.RawByte(',')
+				}
+				if v199 == nil {
+					out.RawString("null")
+				} else {
+					(*v199).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Pinned {
+		const prefix string = ",\"pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Pinned))
+	}
+	if in.PinnedAt != nil {
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PinnedAt).MarshalJSON())
+	}
+	if in.PinnedBy != nil {
+		const prefix string = ",\"pinned_by\":"
+		out.RawString(prefix)
+		(*in.PinnedBy).MarshalEasyJSON(out)
+	}
+	if in.PinExpires != nil {
+		const prefix string = ",\"pin_expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.PinExpires).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "cid", "text", "html", "type", "user", "attachments", "latest_reactions", "own_reactions", "reaction_counts", "parent_id", "show_in_channel", "reply_count", "mentioned_users", "pinned", "pinned_at", "pinned_by", "pin_expires", "created_at", "updated_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo77(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo78(in *jlexer.Lexer, out *MemoryJournal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo78(out *jwriter.Writer, in MemoryJournal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MemoryJournal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo78(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MemoryJournal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo78(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MemoryJournal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo78(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MemoryJournal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo78(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo79(in *jlexer.Lexer, out *LabelThresholds) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag":
+			out.Flag = float32(in.Float32())
+		case "block":
+			out.Block = float32(in.Float32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo79(out *jwriter.Writer, in LabelThresholds) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag\":"
+		out.RawString(prefix[1:])
+		out.Float32(float32(in.Flag))
+	}
+	{
+		const prefix string = ",\"block\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Block))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LabelThresholds) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo79(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LabelThresholds) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo79(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LabelThresholds) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo79(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LabelThresholds) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo79(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo80(in *jlexer.Lexer, out *JournalEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "method":
+			out.Method = string(in.String())
+		case "path":
+			out.Path = string(in.String())
+		case "params":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Params = make(url.Values)
+				} else {
+					out.Params = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v200 []string
+					if in.IsNull() {
+						in.Skip()
+						v200 = nil
+					} else {
+						in.Delim('[')
+						if v200 == nil {
+							if !in.IsDelim(']') {
+								v200 = make([]string, 0, 4)
+							} else {
+								v200 = []string{}
+							}
+						} else {
+							v200 = (v200)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v201 string
+							v201 = string(in.String())
+							v200 = append(v200, v201)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Params)[key] = v200
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "body":
+			if in.IsNull() {
+				in.Skip()
+				out.Body = nil
+			} else {
+				out.Body = in.Bytes()
+			}
+		case "error":
+			out.Error = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo80(out *jwriter.Writer, in JournalEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"method\":"
+		out.RawString(prefix)
+		out.String(string(in.Method))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	if len(in.Params) != 0 {
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v203First := true
+			for v203Name, v203Value := range in.Params {
+				if v203First {
+					v203First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v203Name))
+				out.RawByte(':')
+				if v203Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v204, v205 := range v203Value {
+						if v204 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v205))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Body) != 0 {
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Body)
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JournalEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JournalEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JournalEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JournalEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo80(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo81(in *jlexer.Lexer, out *FlagReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "flags_count":
+			out.FlagsCount = int(in.Int())
+		case "message_user_id":
+			out.MessageUserID = string(in.String())
+		case "channel_cid":
+			out.ChannelCID = string(in.String())
+		case "review_result":
+			out.ReviewResult = string(in.String())
+		case "reviewed_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedBy = nil
+			} else {
+				if out.ReviewedBy == nil {
+					out.ReviewedBy = new(User)
+				}
+				(*out.ReviewedBy).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedAt = nil
+			} else {
+				if out.ReviewedAt == nil {
+					out.ReviewedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReviewedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo81(out *jwriter.Writer, in FlagReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"flags_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlagsCount))
+	}
+	{
+		const prefix string = ",\"message_user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageUserID))
+	}
+	{
+		const prefix string = ",\"channel_cid\":"
+		out.RawString(prefix)
+		out.String(string(in.ChannelCID))
+	}
+	if in.ReviewResult != "" {
+		const prefix string = ",\"review_result\":"
+		out.RawString(prefix)
+		out.String(string(in.ReviewResult))
+	}
+	if in.ReviewedBy != nil {
+		const prefix string = ",\"reviewed_by\":"
+		out.RawString(prefix)
+		(*in.ReviewedBy).MarshalEasyJSON(out)
+	}
+	if in.ReviewedAt != nil {
+		const prefix string = ",\"reviewed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReviewedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlagReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo81(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlagReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo81(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlagReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo81(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlagReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo81(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo82(in *jlexer.Lexer, out *FlagCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TargetID":
+			out.TargetID = string(in.String())
+		case "Count":
+			out.Count = int(in.Int())
+		case "LastAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo82(out *jwriter.Writer, in FlagCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"TargetID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TargetID))
+	}
+	{
+		const prefix string = ",\"Count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"LastAt\":"
+		out.RawString(prefix)
+		out.Raw((in.LastAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlagCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo82(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlagCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo82(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlagCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo82(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlagCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo82(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo83(in *jlexer.Lexer, out *FlagAggregate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*FlagCount, 0, 8)
+					} else {
+						out.Messages = []*FlagCount{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v208 *FlagCount
+					if in.IsNull() {
+						in.Skip()
+						v208 = nil
+					} else {
+						if v208 == nil {
+							v208 = new(FlagCount)
+						}
+						(*v208).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v208)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]*FlagCount, 0, 8)
+					} else {
+						out.Users = []*FlagCount{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v209 *FlagCount
+					if in.IsNull() {
+						in.Skip()
+						v209 = nil
+					} else {
+						if v209 == nil {
+							v209 = new(FlagCount)
+						}
+						(*v209).UnmarshalEasyJSON(in)
+					}
+					out.Users = append(out.Users, v209)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo83(out *jwriter.Writer, in FlagAggregate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v210, v211 := range in.Messages {
+				if v210 > 0 {
+					out.RawByte(',')
+				}
+				if v211 == nil {
+					out.RawString("null")
+				} else {
+					(*v211).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v212, v213 := range in.Users {
+				if v212 > 0 {
+					out.RawByte(',')
+				}
+				if v213 == nil {
+					out.RawString("null")
+				} else {
+					(*v213).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlagAggregate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo83(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlagAggregate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo83(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlagAggregate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo83(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlagAggregate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo83(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo84(in *jlexer.Lexer, out *ExportUserResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v214 *Message
+					if in.IsNull() {
+						in.Skip()
+						v214 = nil
+					} else {
+						if v214 == nil {
+							v214 = new(Message)
+						}
+						(*v214).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v214)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Reactions = nil
+			} else {
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]*Reaction, 0, 8)
+					} else {
+						out.Reactions = []*Reaction{}
+					}
+				} else {
+					out.Reactions = (out.Reactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v215 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v215 = nil
+					} else {
+						if v215 == nil {
+							v215 = new(Reaction)
+						}
+						(*v215).UnmarshalEasyJSON(in)
+					}
+					out.Reactions = append(out.Reactions, v215)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo84(out *jwriter.Writer, in ExportUserResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v216, v217 := range in.Messages {
+				if v216 > 0 {
+					out.RawByte(',')
+				}
+				if v217 == nil {
+					out.RawString("null")
+				} else {
+					(*v217).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v218, v219 := range in.Reactions {
+				if v218 > 0 {
+					out.RawByte(',')
+				}
+				if v219 == nil {
+					out.RawString("null")
+				} else {
+					(*v219).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportUserResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo84(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportUserResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo84(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportUserResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo84(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportUserResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo84(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo85(in *jlexer.Lexer, out *ExportChannelsOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clear_deleted_message_text":
+			out.ClearDeletedMessageText = bool(in.Bool())
+		case "include_truncated_messages":
+			out.IncludeTruncatedMessages = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo85(out *jwriter.Writer, in ExportChannelsOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ClearDeletedMessageText {
+		const prefix string = ",\"clear_deleted_message_text\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.ClearDeletedMessageText))
+	}
+	if in.IncludeTruncatedMessages {
+		const prefix string = ",\"include_truncated_messages\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IncludeTruncatedMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportChannelsOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo85(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportChannelsOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo85(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportChannelsOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo85(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportChannelsOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo85(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo86(in *jlexer.Lexer, out *ExportChannelRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "messages_since":
+			if in.IsNull() {
+				in.Skip()
+				out.MessagesSince = nil
+			} else {
+				if out.MessagesSince == nil {
+					out.MessagesSince = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MessagesSince).UnmarshalJSON(data))
+				}
+			}
+		case "messages_until":
+			if in.IsNull() {
+				in.Skip()
+				out.MessagesUntil = nil
+			} else {
+				if out.MessagesUntil == nil {
+					out.MessagesUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MessagesUntil).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo86(out *jwriter.Writer, in ExportChannelRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.MessagesSince != nil {
+		const prefix string = ",\"messages_since\":"
+		out.RawString(prefix)
+		out.Raw((*in.MessagesSince).MarshalJSON())
+	}
+	if in.MessagesUntil != nil {
+		const prefix string = ",\"messages_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.MessagesUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportChannelRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo86(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportChannelRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo86(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportChannelRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo86(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportChannelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo86(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo87(in *jlexer.Lexer, out *EventHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "hook_type":
+			out.HookType = EventHookType(in.String())
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "event_types":
+			if in.IsNull() {
+				in.Skip()
+				out.EventTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EventTypes == nil {
+					if !in.IsDelim(']') {
+						out.EventTypes = make([]EventType, 0, 4)
+					} else {
+						out.EventTypes = []EventType{}
+					}
+				} else {
+					out.EventTypes = (out.EventTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v220 EventType
+					v220 = EventType(in.String())
+					out.EventTypes = append(out.EventTypes, v220)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "webhook_url":
+			out.WebhookURL = string(in.String())
+		case "sqs_queue_url":
+			out.SQSQueueURL = string(in.String())
+		case "sqs_region":
+			out.SQSRegion = string(in.String())
+		case "sqs_key":
+			out.SQSKey = string(in.String())
+		case "sqs_secret":
+			out.SQSSecret = string(in.String())
+		case "sns_topic_arn":
+			out.SNSTopicARN = string(in.String())
+		case "sns_region":
+			out.SNSRegion = string(in.String())
+		case "sns_key":
+			out.SNSKey = string(in.String())
+		case "sns_secret":
+			out.SNSSecret = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo87(out *jwriter.Writer, in EventHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"hook_type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.HookType))
+	}
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Enabled))
+	}
+	{
+		const prefix string = ",\"event_types\":"
+		out.RawString(prefix)
+		if in.EventTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v221, v222 := range in.EventTypes {
+				if v221 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v222))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.WebhookURL != "" {
+		const prefix string = ",\"webhook_url\":"
+		out.RawString(prefix)
+		out.String(string(in.WebhookURL))
+	}
+	if in.SQSQueueURL != "" {
+		const prefix string = ",\"sqs_queue_url\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSQueueURL))
+	}
+	if in.SQSRegion != "" {
+		const prefix string = ",\"sqs_region\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSRegion))
+	}
+	if in.SQSKey != "" {
+		const prefix string = ",\"sqs_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSKey))
+	}
+	if in.SQSSecret != "" {
+		const prefix string = ",\"sqs_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSSecret))
+	}
+	if in.SNSTopicARN != "" {
+		const prefix string = ",\"sns_topic_arn\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSTopicARN))
+	}
+	if in.SNSRegion != "" {
+		const prefix string = ",\"sns_region\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSRegion))
+	}
+	if in.SNSKey != "" {
+		const prefix string = ",\"sns_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSKey))
+	}
+	if in.SNSSecret != "" {
+		const prefix string = ",\"sns_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSSecret))
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EventHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo87(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EventHook) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo87(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EventHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo87(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EventHook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo87(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo88(in *jlexer.Lexer, out *Event) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "cid":
+			out.CID = string(in.String())
+		case "type":
+			out.Type = EventType(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reaction":
+			if in.IsNull() {
+				in.Skip()
+				out.Reaction = nil
+			} else {
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
+				}
+				(*out.Reaction).UnmarshalEasyJSON(in)
+			}
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
+			}
+		case "member":
+			if in.IsNull() {
+				in.Skip()
+				out.Member = nil
+			} else {
+				if out.Member == nil {
+					out.Member = new(ChannelMember)
+				}
+				(*out.Member).UnmarshalEasyJSON(in)
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		case "me":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnUser = nil
+			} else {
+				if out.OwnUser == nil {
+					out.OwnUser = new(User)
+				}
+				(*out.OwnUser).UnmarshalEasyJSON(in)
+			}
+		case "watcher_count":
+			out.WatcherCount = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo88(out *jwriter.Writer, in Event) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.CID != "" {
+		const prefix string = ",\"cid\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.CID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	if in.Reaction != nil {
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix)
+		(*in.Reaction).MarshalEasyJSON(out)
+	}
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(*in.Channel).MarshalEasyJSON(out)
+	}
+	if in.Member != nil {
+		const prefix string = ",\"member\":"
+		out.RawString(prefix)
+		(*in.Member).MarshalEasyJSON(out)
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.OwnUser != nil {
+		const prefix string = ",\"me\":"
+		out.RawString(prefix)
+		(*in.OwnUser).MarshalEasyJSON(out)
+	}
+	if in.WatcherCount != 0 {
+		const prefix string = ",\"watcher_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.WatcherCount))
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "cid", "type", "message", "reaction", "channel", "member", "user", "user_id", "me", "watcher_count", "created_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Event) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo88(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo88(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Event) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo88(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo88(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo89(in *jlexer.Lexer, out *EnsureChannelResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Input":
+			if in.IsNull() {
+				in.Skip()
+				out.Input = nil
+			} else {
+				if out.Input == nil {
+					out.Input = new(ChannelInput)
+				}
+				(*out.Input).UnmarshalEasyJSON(in)
+			}
+		case "Channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo89(out *jwriter.Writer, in EnsureChannelResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Input\":"
+		out.RawString(prefix[1:])
+		if in.Input == nil {
+			out.RawString("null")
+		} else {
+			(*in.Input).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Channel\":"
+		out.RawString(prefix)
+		if in.Channel == nil {
+			out.RawString("null")
+		} else {
+			(*in.Channel).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EnsureChannelResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo89(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EnsureChannelResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo89(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EnsureChannelResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo89(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EnsureChannelResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo89(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo90(in *jlexer.Lexer, out *Endpoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "method":
+			out.Method = string(in.String())
+		case "path":
+			out.Path = string(in.String())
+		case "func":
+			out.Func = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo90(out *jwriter.Writer, in Endpoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"method\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Method))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"func\":"
+		out.RawString(prefix)
+		out.String(string(in.Func))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Endpoint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo90(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Endpoint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo90(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Endpoint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo90(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Endpoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo90(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo91(in *jlexer.Lexer, out *DeviceError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "error_message":
+			out.ErrorMessage = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo91(out *jwriter.Writer, in DeviceError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"provider\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	{
+		const prefix string = ",\"error_message\":"
+		out.RawString(prefix)
+		out.String(string(in.ErrorMessage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeviceError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo91(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeviceError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo91(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeviceError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo91(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeviceError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo91(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo92(in *jlexer.Lexer, out *Device) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "push_provider":
+			out.PushProvider = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo92(out *jwriter.Writer, in Device) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"push_provider\":"
+		out.RawString(prefix)
+		out.String(string(in.PushProvider))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Device) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo92(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo92(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Device) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo92(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo92(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo93(in *jlexer.Lexer, out *DeleteUsersOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "User":
+			out.User = DeleteType(in.String())
+		case "Messages":
+			out.Messages = DeleteType(in.String())
+		case "Conversations":
+			out.Conversations = DeleteType(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo93(out *jwriter.Writer, in DeleteUsersOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"User\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"Messages\":"
+		out.RawString(prefix)
+		out.String(string(in.Messages))
+	}
+	{
+		const prefix string = ",\"Conversations\":"
+		out.RawString(prefix)
+		out.String(string(in.Conversations))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteUsersOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo93(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteUsersOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo93(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteUsersOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo93(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteUsersOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo93(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo94(in *jlexer.Lexer, out *Command) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "args":
+			out.Args = string(in.String())
+		case "set":
+			out.Set = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo94(out *jwriter.Writer, in Command) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"args\":"
+		out.RawString(prefix)
+		out.String(string(in.Args))
+	}
+	{
+		const prefix string = ",\"set\":"
+		out.RawString(prefix)
+		out.String(string(in.Set))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Command) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo94(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Command) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo94(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Command) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo94(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Command) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo94(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo95(in *jlexer.Lexer, out *Client) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo95(out *jwriter.Writer, in Client) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Client) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo95(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo95(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Client) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo95(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo95(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo96(in *jlexer.Lexer, out *CheckSQSResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = CheckStatus(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v223 interface{}
+					if m, ok := v223.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v223.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v223 = in.Interface()
+					}
+					(out.Data)[key] = v223
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo96(out *jwriter.Writer, in CheckSQSResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v224First := true
+			for v224Name, v224Value := range in.Data {
+				if v224First {
+					v224First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v224Name))
+				out.RawByte(':')
+				if m, ok := v224Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v224Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v224Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckSQSResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo96(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckSQSResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo96(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckSQSResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo96(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckSQSResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo96(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo97(in *jlexer.Lexer, out *CheckSNSResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = CheckStatus(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v225 interface{}
+					if m, ok := v225.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v225.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v225 = in.Interface()
+					}
+					(out.Data)[key] = v225
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo97(out *jwriter.Writer, in CheckSNSResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v226First := true
+			for v226Name, v226Value := range in.Data {
+				if v226First {
+					v226First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v226Name))
+				out.RawByte(':')
+				if m, ok := v226Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v226Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v226Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckSNSResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo97(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckSNSResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo97(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckSNSResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo97(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckSNSResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo97(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo98(in *jlexer.Lexer, out *CheckPushResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_errors":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.DeviceErrors = make(map[string]DeviceError)
+				} else {
+					out.DeviceErrors = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v227 DeviceError
+					(v227).UnmarshalEasyJSON(in)
+					(out.DeviceErrors)[key] = v227
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "general_errors":
+			if in.IsNull() {
+				in.Skip()
+				out.GeneralErrors = nil
+			} else {
+				in.Delim('[')
+				if out.GeneralErrors == nil {
+					if !in.IsDelim(']') {
+						out.GeneralErrors = make([]string, 0, 4)
+					} else {
+						out.GeneralErrors = []string{}
+					}
+				} else {
+					out.GeneralErrors = (out.GeneralErrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v228 string
+					v228 = string(in.String())
+					out.GeneralErrors = append(out.GeneralErrors, v228)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "skip_devices":
+			out.SkipDevices = bool(in.Bool())
+		case "rendered_apn_template":
+			out.RenderedApnTemplate = string(in.String())
+		case "rendered_firebase_template":
+			out.RenderedFirebaseTemplate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo98(out *jwriter.Writer, in CheckPushResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_errors\":"
+		out.RawString(prefix[1:])
+		if in.DeviceErrors == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v229First := true
+			for v229Name, v229Value := range in.DeviceErrors {
+				if v229First {
+					v229First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v229Name))
+				out.RawByte(':')
+				(v229Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"general_errors\":"
+		out.RawString(prefix)
+		if in.GeneralErrors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v230, v231 := range in.GeneralErrors {
+				if v230 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v231))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"skip_devices\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipDevices))
+	}
+	{
+		const prefix string = ",\"rendered_apn_template\":"
+		out.RawString(prefix)
+		out.String(string(in.RenderedApnTemplate))
+	}
+	{
+		const prefix string = ",\"rendered_firebase_template\":"
+		out.RawString(prefix)
+		out.String(string(in.RenderedFirebaseTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckPushResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo98(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckPushResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo98(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckPushResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo98(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckPushResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo98(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo99(in *jlexer.Lexer, out *CheckPushRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "apn_template":
+			out.ApnTemplate = string(in.String())
+		case "firebase_template":
+			out.FirebaseTemplate = string(in.String())
+		case "skip_devices":
+			out.SkipDevices = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo99(out *jwriter.Writer, in CheckPushRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.MessageID != "" {
+		const prefix string = ",\"message_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserID))
+	}
+	if in.ApnTemplate != "" {
+		const prefix string = ",\"apn_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ApnTemplate))
+	}
+	if in.FirebaseTemplate != "" {
+		const prefix string = ",\"firebase_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FirebaseTemplate))
+	}
+	if in.SkipDevices {
+		const prefix string = ",\"skip_devices\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.SkipDevices))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckPushRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo99(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckPushRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo99(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckPushRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo99(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckPushRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo99(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo100(in *jlexer.Lexer, out *ChannelType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]*Command, 0, 8)
+					} else {
+						out.Commands = []*Command{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v232 *Command
+					if in.IsNull() {
+						in.Skip()
+						v232 = nil
+					} else {
+						if v232 == nil {
+							v232 = new(Command)
+						}
+						(*v232).UnmarshalEasyJSON(in)
+					}
+					out.Commands = append(out.Commands, v232)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]*Permission, 0, 8)
+					} else {
+						out.Permissions = []*Permission{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v233 *Permission
+					if in.IsNull() {
+						in.Skip()
+						v233 = nil
+					} else {
+						if v233 == nil {
+							v233 = new(Permission)
+						}
+						(*v233).UnmarshalEasyJSON(in)
+					}
+					out.Permissions = append(out.Permissions, v233)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = blocklistBehavior(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo100(out *jwriter.Writer, in ChannelType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v234, v235 := range in.Commands {
+				if v234 > 0 {
+					out.RawByte(',')
+				}
+				if v235 == nil {
+					out.RawString("null")
+				} else {
+					(*v235).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v236, v237 := range in.Permissions {
+				if v236 > 0 {
+					out.RawByte(',')
+				}
+				if v237 == nil {
+					out.RawString("null")
+				} else {
+					(*v237).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
+	{
+		const prefix string = ",\"connect_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ConnectEvents))
+	}
+	{
+		const prefix string = ",\"search\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Search))
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reactions))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replies))
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutes))
+	}
+	{
+		const prefix string = ",\"message_retention\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageRetention))
+	}
+	{
+		const prefix string = ",\"max_message_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMessageLength))
+	}
+	{
+		const prefix string = ",\"automod\":"
+		out.RawString(prefix)
+		out.String(string(in.Automod))
+	}
+	{
+		const prefix string = ",\"automod_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo100(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo100(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo100(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo100(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo101(in *jlexer.Lexer, out *ChannelMute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
+			}
+		case "expires":
+			if in.IsNull() {
+				in.Skip()
+				out.Expires = nil
+			} else {
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo101(out *jwriter.Writer, in ChannelMute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		if in.Channel == nil {
+			out.RawString("null")
+		} else {
+			(*in.Channel).MarshalEasyJSON(out)
+		}
+	}
+	if in.Expires != nil {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.Expires).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelMute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo101(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelMute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo101(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelMute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo101(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelMute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo101(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo102(in *jlexer.Lexer, out *ChannelMember) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "is_moderator":
+			out.IsModerator = bool(in.Bool())
+		case "invited":
+			out.Invited = bool(in.Bool())
+		case "invite_accepted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.InviteAcceptedAt = nil
+			} else {
+				if out.InviteAcceptedAt == nil {
+					out.InviteAcceptedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.InviteAcceptedAt).UnmarshalJSON(data))
+				}
+			}
+		case "invite_rejected_at":
+			if in.IsNull() {
+				in.Skip()
+				out.InviteRejectedAt = nil
+			} else {
+				if out.InviteRejectedAt == nil {
+					out.InviteRejectedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.InviteRejectedAt).UnmarshalJSON(data))
+				}
+			}
+		case "role":
+			out.Role = string(in.String())
+		case "channel_role":
+			out.ChannelRole = string(in.String())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "pinned_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAt = nil
+			} else {
+				if out.PinnedAt == nil {
+					out.PinnedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo102(out *jwriter.Writer, in ChannelMember) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.IsModerator {
+		const prefix string = ",\"is_moderator\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsModerator))
+	}
+	if in.Invited {
+		const prefix string = ",\"invited\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Invited))
+	}
+	if in.InviteAcceptedAt != nil {
+		const prefix string = ",\"invite_accepted_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.InviteAcceptedAt).MarshalJSON())
+	}
+	if in.InviteRejectedAt != nil {
+		const prefix string = ",\"invite_rejected_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.InviteRejectedAt).MarshalJSON())
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Role))
+	}
+	if in.ChannelRole != "" {
+		const prefix string = ",\"channel_role\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ChannelRole))
+	}
+	if in.ArchivedAt != nil {
+		const prefix string = ",\"archived_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ArchivedAt).MarshalJSON())
+	}
+	if in.PinnedAt != nil {
+		const prefix string = ",\"pinned_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PinnedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updated_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "user_id", "user", "is_moderator", "invited", "invite_accepted_at", "invite_rejected_at", "role", "channel_role", "archived_at", "pinned_at", "created_at", "updated_at":
+			continue // don't allow field overwrites
+		}
+		if first {
+			first = false
+		} else {
+			out.RawByte(',')
+		}
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelMember) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo102(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelMember) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo102(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelMember) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo102(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo102(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo103(in *jlexer.Lexer, out *ChannelInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Type":
+			out.Type = string(in.String())
+		case "ID":
+			out.ID = string(in.String())
+		case "CreatedByID":
+			out.CreatedByID = string(in.String())
+		case "Data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v238 interface{}
+					if m, ok := v238.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v238.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v238 = in.Interface()
+					}
+					(out.Data)[key] = v238
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo103(out *jwriter.Writer, in ChannelInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"CreatedByID\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedByID))
+	}
+	{
+		const prefix string = ",\"Data\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v239First := true
+			for v239Name, v239Value := range in.Data {
+				if v239First {
+					v239First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v239Name))
+				out.RawByte(':')
+				if m, ok := v239Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v239Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v239Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo103(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo103(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo103(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo103(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo104(in *jlexer.Lexer, out *ChannelConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = blocklistBehavior(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo104(out *jwriter.Writer, in ChannelConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
 	{
-		const prefix string = ",\"own_reactions\":"
+		const prefix string = ",\"connect_events\":"
 		out.RawString(prefix)
-		if in.OwnReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v59, v60 := range in.OwnReactions {
-				if v59 > 0 {
-					out.RawByte(',')
-				}
-				if v60 == nil {
-					out.RawString("null")
-				} else {
-					(*v60).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Bool(bool(in.ConnectEvents))
 	}
 	{
-		const prefix string = ",\"reaction_counts\":"
+		const prefix string = ",\"search\":"
 		out.RawString(prefix)
-		if in.ReactionCounts == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v61First := true
-			for v61Name, v61Value := range in.ReactionCounts {
-				if v61First {
-					v61First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v61Name))
-				out.RawByte(':')
-				out.Int(int(v61Value))
-			}
-			out.RawByte('}')
-		}
+		out.Bool(bool(in.Search))
 	}
 	{
-		const prefix string = ",\"parent_id\":"
+		const prefix string = ",\"reactions\":"
 		out.RawString(prefix)
-		out.String(string(in.ParentID))
+		out.Bool(bool(in.Reactions))
 	}
 	{
-		const prefix string = ",\"show_in_channel\":"
+		const prefix string = ",\"replies\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ShowInChannel))
+		out.Bool(bool(in.Replies))
 	}
 	{
-		const prefix string = ",\"reply_count\":"
+		const prefix string = ",\"mutes\":"
 		out.RawString(prefix)
-		out.Int(int(in.ReplyCount))
+		out.Bool(bool(in.Mutes))
 	}
 	{
-		const prefix string = ",\"mentioned_users\":"
+		const prefix string = ",\"message_retention\":"
 		out.RawString(prefix)
-		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v62, v63 := range in.MentionedUsers {
-				if v62 > 0 {
-					out.RawByte(',')
-				}
-				if v63 == nil {
-					out.RawString("null")
-				} else {
-					(*v63).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.MessageRetention))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"max_message_length\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int(int(in.MaxMessageLength))
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"automod\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.Automod))
 	}
 	{
-		const prefix string = ",\"ExtraData\":"
+		const prefix string = ",\"automod_behavior\":"
 		out.RawString(prefix)
-		if in.ExtraData == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v64First := true
-			for v64Name, v64Value := range in.ExtraData {
-				if v64First {
-					v64First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v64Name))
-				out.RawByte(':')
-				if m, ok := v64Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v64Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v64Value))
-				}
-			}
-			out.RawByte('}')
-		}
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v ChannelConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(w, v)
+func (v ChannelConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *ChannelConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(l, v)
+func (v *ChannelConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo104(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, out *Event) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo105(in *jlexer.Lexer, out *Channel) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2983,72 +13680,161 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 			in.WantComma()
 			continue
 		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
 		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		case "cid":
 			out.CID = string(in.String())
-		case "type":
-			out.Type = EventType(in.String())
-		case "message":
+		case "config":
+			(out.Config).UnmarshalEasyJSON(in)
+		case "created_by":
 			if in.IsNull() {
 				in.Skip()
-				out.Message = nil
+				out.CreatedBy = nil
 			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(User)
 				}
-				(*out.Message).UnmarshalEasyJSON(in)
+				(*out.CreatedBy).UnmarshalEasyJSON(in)
 			}
-		case "reaction":
+		case "frozen":
+			out.Frozen = bool(in.Bool())
+		case "disabled":
+			out.Disabled = bool(in.Bool())
+		case "team":
+			out.Team = string(in.String())
+		case "cooldown":
+			out.Cooldown = int(in.Int())
+		case "member_count":
+			out.MemberCount = int(in.Int())
+		case "members":
 			if in.IsNull() {
 				in.Skip()
-				out.Reaction = nil
+				out.Members = nil
 			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
 				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
-			}
-		case "channel":
-			if in.IsNull() {
-				in.Skip()
-				out.Channel = nil
-			} else {
-				if out.Channel == nil {
-					out.Channel = new(Channel)
+				for !in.IsDelim(']') {
+					var v240 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v240 = nil
+					} else {
+						if v240 == nil {
+							v240 = new(ChannelMember)
+						}
+						(*v240).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v240)
+					in.WantComma()
 				}
-				(*out.Channel).UnmarshalEasyJSON(in)
+				in.Delim(']')
 			}
-		case "member":
+		case "messages":
 			if in.IsNull() {
 				in.Skip()
-				out.Member = nil
+				out.Messages = nil
 			} else {
-				if out.Member == nil {
-					out.Member = new(ChannelMember)
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
 				}
-				(*out.Member).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v241 *Message
+					if in.IsNull() {
+						in.Skip()
+						v241 = nil
+					} else {
+						if v241 == nil {
+							v241 = new(Message)
+						}
+						(*v241).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v241)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "user":
+		case "read":
 			if in.IsNull() {
 				in.Skip()
-				out.User = nil
+				out.Read = nil
 			} else {
-				if out.User == nil {
-					out.User = new(User)
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*User, 0, 8)
+					} else {
+						out.Read = []*User{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
 				}
-				(*out.User).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v242 *User
+					if in.IsNull() {
+						in.Skip()
+						v242 = nil
+					} else {
+						if v242 == nil {
+							v242 = new(User)
+						}
+						(*v242).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v242)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "user_id":
-			out.UserID = string(in.String())
-		case "me":
+		case "watchers":
 			if in.IsNull() {
 				in.Skip()
-				out.OwnUser = nil
+				out.Watchers = nil
 			} else {
-				if out.OwnUser == nil {
-					out.OwnUser = new(User)
+				in.Delim('[')
+				if out.Watchers == nil {
+					if !in.IsDelim(']') {
+						out.Watchers = make([]*User, 0, 8)
+					} else {
+						out.Watchers = []*User{}
+					}
+				} else {
+					out.Watchers = (out.Watchers)[:0]
 				}
-				(*out.OwnUser).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v243 *User
+					if in.IsNull() {
+						in.Skip()
+						v243 = nil
+					} else {
+						if v243 == nil {
+							v243 = new(User)
+						}
+						(*v243).UnmarshalEasyJSON(in)
+					}
+					out.Watchers = append(out.Watchers, v243)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		case "watcher_count":
 			out.WatcherCount = int(in.Int())
@@ -3056,8 +13842,19 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "last_message_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastMessageAt).UnmarshalJSON(data))
+			}
 		default:
-			in.SkipRecursive()
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
 		}
 		in.WantComma()
 	}
@@ -3066,98 +13863,207 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(out *jwriter.Writer, in Event) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo105(out *jwriter.Writer, in Channel) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.CID != "" {
-		const prefix string = ",\"cid\":"
-		first = false
+	{
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"cid\":"
+		out.RawString(prefix)
 		out.String(string(in.CID))
 	}
 	{
-		const prefix string = ",\"type\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		const prefix string = ",\"config\":"
+		out.RawString(prefix)
+		(in.Config).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		if in.CreatedBy == nil {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			(*in.CreatedBy).MarshalEasyJSON(out)
 		}
-		out.String(string(in.Type))
 	}
-	if in.Message != nil {
-		const prefix string = ",\"message\":"
+	{
+		const prefix string = ",\"frozen\":"
 		out.RawString(prefix)
-		(*in.Message).MarshalEasyJSON(out)
+		out.Bool(bool(in.Frozen))
 	}
-	if in.Reaction != nil {
-		const prefix string = ",\"reaction\":"
+	{
+		const prefix string = ",\"disabled\":"
 		out.RawString(prefix)
-		(*in.Reaction).MarshalEasyJSON(out)
+		out.Bool(bool(in.Disabled))
 	}
-	if in.Channel != nil {
-		const prefix string = ",\"channel\":"
+	if in.Team != "" {
+		const prefix string = ",\"team\":"
 		out.RawString(prefix)
-		(*in.Channel).MarshalEasyJSON(out)
+		out.String(string(in.Team))
 	}
-	if in.Member != nil {
-		const prefix string = ",\"member\":"
+	{
+		const prefix string = ",\"cooldown\":"
 		out.RawString(prefix)
-		(*in.Member).MarshalEasyJSON(out)
+		out.Int(int(in.Cooldown))
 	}
-	if in.User != nil {
-		const prefix string = ",\"user\":"
+	{
+		const prefix string = ",\"member_count\":"
 		out.RawString(prefix)
-		(*in.User).MarshalEasyJSON(out)
+		out.Int(int(in.MemberCount))
 	}
-	if in.UserID != "" {
-		const prefix string = ",\"user_id\":"
+	{
+		const prefix string = ",\"members\":"
 		out.RawString(prefix)
-		out.String(string(in.UserID))
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v244, v245 := range in.Members {
+				if v244 > 0 {
+					out.RawByte(',')
+				}
+				if v245 == nil {
+					out.RawString("null")
+				} else {
+					(*v245).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.OwnUser != nil {
-		const prefix string = ",\"me\":"
+	{
+		const prefix string = ",\"messages\":"
 		out.RawString(prefix)
-		(*in.OwnUser).MarshalEasyJSON(out)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v246, v247 := range in.Messages {
+				if v246 > 0 {
+					out.RawByte(',')
+				}
+				if v247 == nil {
+					out.RawString("null")
+				} else {
+					(*v247).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.WatcherCount != 0 {
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v248, v249 := range in.Read {
+				if v248 > 0 {
+					out.RawByte(',')
+				}
+				if v249 == nil {
+					out.RawString("null")
+				} else {
+					(*v249).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"watchers\":"
+		out.RawString(prefix)
+		if in.Watchers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v250, v251 := range in.Watchers {
+				if v250 > 0 {
+					out.RawByte(',')
+				}
+				if v251 == nil {
+					out.RawString("null")
+				} else {
+					(*v251).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
 		const prefix string = ",\"watcher_count\":"
 		out.RawString(prefix)
 		out.Int(int(in.WatcherCount))
 	}
-	if true {
+	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
 		out.Raw((in.CreatedAt).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_message_at\":"
+		out.RawString(prefix)
+		out.Raw((in.LastMessageAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "type", "cid", "config", "created_by", "frozen", "disabled", "team", "cooldown", "member_count", "members", "messages", "read", "watchers", "watcher_count", "created_at", "updated_at", "last_message_at", "-":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Event) MarshalJSON() ([]byte, error) {
+func (v Channel) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo105(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(w, v)
+func (v Channel) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo105(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Event) UnmarshalJSON(data []byte) error {
+func (v *Channel) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo105(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(l, v)
+func (v *Channel) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo105(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, out *Device) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo106(in *jlexer.Lexer, out *BlocklistSync) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3176,12 +14082,60 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "user_id":
-			out.UserID = string(in.String())
-		case "push_provider":
-			out.PushProvider = string(in.String())
+		case "Added":
+			if in.IsNull() {
+				in.Skip()
+				out.Added = nil
+			} else {
+				in.Delim('[')
+				if out.Added == nil {
+					if !in.IsDelim(']') {
+						out.Added = make([]string, 0, 4)
+					} else {
+						out.Added = []string{}
+					}
+				} else {
+					out.Added = (out.Added)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v252 string
+					v252 = string(in.String())
+					out.Added = append(out.Added, v252)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Removed":
+			if in.IsNull() {
+				in.Skip()
+				out.Removed = nil
+			} else {
+				in.Delim('[')
+				if out.Removed == nil {
+					if !in.IsDelim(']') {
+						out.Removed = make([]string, 0, 4)
+					} else {
+						out.Removed = []string{}
+					}
+				} else {
+					out.Removed = (out.Removed)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v253 string
+					v253 = string(in.String())
+					out.Removed = append(out.Removed, v253)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Hash":
+			out.Hash = string(in.String())
+		case "ETag":
+			out.ETag = string(in.String())
+		case "Created":
+			out.Created = bool(in.Bool())
+		case "Updated":
+			out.Updated = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3192,139 +14146,89 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(out *jwriter.Writer, in Device) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo106(out *jwriter.Writer, in BlocklistSync) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"Added\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.String(string(in.UserID))
+		if in.Added == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v254, v255 := range in.Added {
+				if v254 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v255))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"push_provider\":"
+		const prefix string = ",\"Removed\":"
 		out.RawString(prefix)
-		out.String(string(in.PushProvider))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Device) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Device) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(l, v)
-}
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(in *jlexer.Lexer, out *Command) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "args":
-			out.Args = string(in.String())
-		case "set":
-			out.Set = string(in.String())
-		default:
-			in.SkipRecursive()
+		if in.Removed == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v256, v257 := range in.Removed {
+				if v256 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v257))
+			}
+			out.RawByte(']')
 		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
 	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(out *jwriter.Writer, in Command) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		const prefix string = ",\"Hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"ETag\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.ETag))
 	}
 	{
-		const prefix string = ",\"args\":"
+		const prefix string = ",\"Created\":"
 		out.RawString(prefix)
-		out.String(string(in.Args))
+		out.Bool(bool(in.Created))
 	}
 	{
-		const prefix string = ",\"set\":"
+		const prefix string = ",\"Updated\":"
 		out.RawString(prefix)
-		out.String(string(in.Set))
+		out.Bool(bool(in.Updated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Command) MarshalJSON() ([]byte, error) {
+func (v BlocklistSync) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo106(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Command) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(w, v)
+func (v BlocklistSync) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo106(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Command) UnmarshalJSON(data []byte) error {
+func (v *BlocklistSync) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo106(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Command) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(l, v)
+func (v *BlocklistSync) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo106(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, out *Client) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo107(in *jlexer.Lexer, out *Blocklist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3343,6 +14247,39 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "words":
+			if in.IsNull() {
+				in.Skip()
+				out.Words = nil
+			} else {
+				in.Delim('[')
+				if out.Words == nil {
+					if !in.IsDelim(']') {
+						out.Words = make([]string, 0, 4)
+					} else {
+						out.Words = []string{}
+					}
+				} else {
+					out.Words = (out.Words)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v258 string
+					v258 = string(in.String())
+					out.Words = append(out.Words, v258)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3353,37 +14290,68 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(out *jwriter.Writer, in Client) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo107(out *jwriter.Writer, in Blocklist) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"words\":"
+		out.RawString(prefix)
+		if in.Words == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v259, v260 := range in.Words {
+				if v259 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v260))
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Client) MarshalJSON() ([]byte, error) {
+func (v Blocklist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo107(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(w, v)
+func (v Blocklist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo107(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Client) UnmarshalJSON(data []byte) error {
+func (v *Blocklist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo107(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(l, v)
+func (v *Blocklist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo107(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, out *ChannelType) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo108(in *jlexer.Lexer, out *BlockedUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3402,100 +14370,34 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "commands":
+		case "user_id":
+			out.UserID = string(in.String())
+		case "blocked_user_id":
+			out.BlockedUserID = string(in.String())
+		case "user":
 			if in.IsNull() {
 				in.Skip()
-				out.Commands = nil
+				out.User = nil
 			} else {
-				in.Delim('[')
-				if out.Commands == nil {
-					if !in.IsDelim(']') {
-						out.Commands = make([]*Command, 0, 8)
-					} else {
-						out.Commands = []*Command{}
-					}
-				} else {
-					out.Commands = (out.Commands)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v65 *Command
-					if in.IsNull() {
-						in.Skip()
-						v65 = nil
-					} else {
-						if v65 == nil {
-							v65 = new(Command)
-						}
-						(*v65).UnmarshalEasyJSON(in)
-					}
-					out.Commands = append(out.Commands, v65)
-					in.WantComma()
+				if out.User == nil {
+					out.User = new(User)
 				}
-				in.Delim(']')
+				(*out.User).UnmarshalEasyJSON(in)
 			}
-		case "permissions":
+		case "blocked_user":
 			if in.IsNull() {
 				in.Skip()
-				out.Permissions = nil
+				out.BlockedUser = nil
 			} else {
-				in.Delim('[')
-				if out.Permissions == nil {
-					if !in.IsDelim(']') {
-						out.Permissions = make([]*Permission, 0, 8)
-					} else {
-						out.Permissions = []*Permission{}
-					}
-				} else {
-					out.Permissions = (out.Permissions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v66 *Permission
-					if in.IsNull() {
-						in.Skip()
-						v66 = nil
-					} else {
-						if v66 == nil {
-							v66 = new(Permission)
-						}
-						(*v66).UnmarshalEasyJSON(in)
-					}
-					out.Permissions = append(out.Permissions, v66)
-					in.WantComma()
+				if out.BlockedUser == nil {
+					out.BlockedUser = new(User)
 				}
-				in.Delim(']')
+				(*out.BlockedUser).UnmarshalEasyJSON(in)
 			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3506,147 +14408,70 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(out *jwriter.Writer, in ChannelType) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo108(out *jwriter.Writer, in BlockedUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commands\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v67, v68 := range in.Commands {
-				if v67 > 0 {
-					out.RawByte(',')
-				}
-				if v68 == nil {
-					out.RawString("null")
-				} else {
-					(*v68).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"permissions\":"
-		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v69, v70 := range in.Permissions {
-				if v69 > 0 {
-					out.RawByte(',')
-				}
-				if v70 == nil {
-					out.RawString("null")
-				} else {
-					(*v70).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"typing_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
-	}
-	{
-		const prefix string = ",\"read_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
-	}
-	{
-		const prefix string = ",\"connect_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
-	}
-	{
-		const prefix string = ",\"search\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Search))
-	}
-	{
-		const prefix string = ",\"reactions\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
-	}
-	{
-		const prefix string = ",\"replies\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
-	}
-	{
-		const prefix string = ",\"mutes\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
+		out.String(string(in.UserID))
 	}
 	{
-		const prefix string = ",\"message_retention\":"
+		const prefix string = ",\"blocked_user_id\":"
 		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
+		out.String(string(in.BlockedUserID))
 	}
 	{
-		const prefix string = ",\"max_message_length\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"automod\":"
+		const prefix string = ",\"blocked_user\":"
 		out.RawString(prefix)
-		out.String(string(in.Automod))
+		if in.BlockedUser == nil {
+			out.RawString("null")
+		} else {
+			(*in.BlockedUser).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"automod_behavior\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelType) MarshalJSON() ([]byte, error) {
+func (v BlockedUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo108(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(w, v)
+func (v BlockedUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo108(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelType) UnmarshalJSON(data []byte) error {
+func (v *BlockedUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo108(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(l, v)
+func (v *BlockedUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo108(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, out *ChannelMember) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo109(in *jlexer.Lexer, out *Ban) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3665,8 +14490,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = string(in.String())
 		case "user":
 			if in.IsNull() {
 				in.Skip()
@@ -3677,44 +14500,72 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 				}
 				(*out.User).UnmarshalEasyJSON(in)
 			}
-		case "is_moderator":
-			out.IsModerator = bool(in.Bool())
-		case "invited":
-			out.Invited = bool(in.Bool())
-		case "invite_accepted_at":
+		case "banned_by":
 			if in.IsNull() {
 				in.Skip()
-				out.InviteAcceptedAt = nil
+				out.BannedBy = nil
 			} else {
-				if out.InviteAcceptedAt == nil {
-					out.InviteAcceptedAt = new(time.Time)
+				if out.BannedBy == nil {
+					out.BannedBy = new(User)
 				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.InviteAcceptedAt).UnmarshalJSON(data))
+				(*out.BannedBy).UnmarshalEasyJSON(in)
+			}
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
 				}
+				(*out.Channel).UnmarshalEasyJSON(in)
 			}
-		case "invite_rejected_at":
+		case "reason":
+			out.Reason = string(in.String())
+		case "shadow":
+			out.Shadow = bool(in.Bool())
+		case "expires":
 			if in.IsNull() {
 				in.Skip()
-				out.InviteRejectedAt = nil
+				out.Expires = nil
 			} else {
-				if out.InviteRejectedAt == nil {
-					out.InviteRejectedAt = new(time.Time)
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
 				}
 				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.InviteRejectedAt).UnmarshalJSON(data))
+					in.AddError((*out.Expires).UnmarshalJSON(data))
 				}
 			}
-		case "role":
-			out.Role = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v261 interface{}
+					if m, ok := v261.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v261.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v261 = in.Interface()
+					}
+					(out.Data)[key] = v261
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3725,123 +14576,290 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(out *jwriter.Writer, in ChannelMember) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo109(out *jwriter.Writer, in Ban) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.UserID != "" {
-		const prefix string = ",\"user_id\":"
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	if in.BannedBy != nil {
+		const prefix string = ",\"banned_by\":"
+		out.RawString(prefix)
+		(*in.BannedBy).MarshalEasyJSON(out)
+	}
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(*in.Channel).MarshalEasyJSON(out)
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Shadow {
+		const prefix string = ",\"shadow\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shadow))
+	}
+	if in.Expires != nil {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.Expires).MarshalJSON())
+	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v262First := true
+			for v262Name, v262Value := range in.Data {
+				if v262First {
+					v262First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v262Name))
+				out.RawByte(':')
+				if m, ok := v262Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v262Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v262Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Ban) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo109(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Ban) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo109(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Ban) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo109(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Ban) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo109(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo110(in *jlexer.Lexer, out *Attachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		for key := range out.ExtraData {
+			delete(out.ExtraData, key)
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "author_name":
+			out.AuthorName = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "title_link":
+			out.TitleLink = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "image_url":
+			out.ImageURL = string(in.String())
+		case "thumb_url":
+			out.ThumbURL = string(in.String())
+		case "asset_url":
+			out.AssetURL = string(in.String())
+		case "og_scrape_url":
+			out.OGScrapeURL = string(in.String())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo110(out *jwriter.Writer, in Attachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.UserID))
+		out.String(string(in.Type))
 	}
-	if in.User != nil {
-		const prefix string = ",\"user\":"
+	if in.AuthorName != "" {
+		const prefix string = ",\"author_name\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		(*in.User).MarshalEasyJSON(out)
+		out.String(string(in.AuthorName))
 	}
-	if in.IsModerator {
-		const prefix string = ",\"is_moderator\":"
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Bool(bool(in.IsModerator))
+		out.String(string(in.Title))
 	}
-	if in.Invited {
-		const prefix string = ",\"invited\":"
+	if in.TitleLink != "" {
+		const prefix string = ",\"title_link\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Bool(bool(in.Invited))
+		out.String(string(in.TitleLink))
 	}
-	if in.InviteAcceptedAt != nil {
-		const prefix string = ",\"invite_accepted_at\":"
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((*in.InviteAcceptedAt).MarshalJSON())
+		out.String(string(in.Text))
 	}
-	if in.InviteRejectedAt != nil {
-		const prefix string = ",\"invite_rejected_at\":"
+	if in.ImageURL != "" {
+		const prefix string = ",\"image_url\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((*in.InviteRejectedAt).MarshalJSON())
+		out.String(string(in.ImageURL))
 	}
-	if in.Role != "" {
-		const prefix string = ",\"role\":"
+	if in.ThumbURL != "" {
+		const prefix string = ",\"thumb_url\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Role))
+		out.String(string(in.ThumbURL))
 	}
-	if true {
-		const prefix string = ",\"created_at\":"
+	if in.AssetURL != "" {
+		const prefix string = ",\"asset_url\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.AssetURL))
 	}
-	if true {
-		const prefix string = ",\"updated_at\":"
+	if in.OGScrapeURL != "" {
+		const prefix string = ",\"og_scrape_url\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.OGScrapeURL))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "type", "author_name", "title", "title_link", "text", "image_url", "thumb_url", "asset_url", "og_scrape_url":
+			continue // don't allow field overwrites
+		}
+		if first {
+			first = false
+		} else {
+			out.RawByte(',')
+		}
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelMember) MarshalJSON() ([]byte, error) {
+func (v Attachment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo110(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelMember) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(w, v)
+func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo110(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelMember) UnmarshalJSON(data []byte) error {
+func (v *Attachment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo110(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(l, v)
+func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo110(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, out *ChannelConfig) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo111(in *jlexer.Lexer, out *ArchiveState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3860,30 +14878,18 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "task_id":
+			out.TaskID = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "sha256":
+			out.SHA256 = string(in.String())
+		case "deleted":
+			out.Deleted = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3894,97 +14900,67 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(out *jwriter.Writer, in ChannelConfig) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo111(out *jwriter.Writer, in ArchiveState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"typing_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
-	}
-	{
-		const prefix string = ",\"read_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
-	}
-	{
-		const prefix string = ",\"connect_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
-	}
-	{
-		const prefix string = ",\"search\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Search))
-	}
-	{
-		const prefix string = ",\"reactions\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
-	}
-	{
-		const prefix string = ",\"replies\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"mutes\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
+		out.String(string(in.ID))
 	}
-	{
-		const prefix string = ",\"message_retention\":"
+	if in.TaskID != "" {
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
+		out.String(string(in.TaskID))
 	}
-	{
-		const prefix string = ",\"max_message_length\":"
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
+		out.String(string(in.URL))
 	}
-	{
-		const prefix string = ",\"automod\":"
+	if in.SHA256 != "" {
+		const prefix string = ",\"sha256\":"
 		out.RawString(prefix)
-		out.String(string(in.Automod))
+		out.String(string(in.SHA256))
 	}
-	{
-		const prefix string = ",\"automod_behavior\":"
+	if in.Deleted {
+		const prefix string = ",\"deleted\":"
 		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
+		out.Bool(bool(in.Deleted))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelConfig) MarshalJSON() ([]byte, error) {
+func (v ArchiveState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo111(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelConfig) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(w, v)
+func (v ArchiveState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo111(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelConfig) UnmarshalJSON(data []byte) error {
+func (v *ArchiveState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo111(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(l, v)
+func (v *ArchiveState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo111(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, out *Channel) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo112(in *jlexer.Lexer, out *ArchiveOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4003,133 +14979,24 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "cid":
-			out.CID = string(in.String())
-		case "config":
-			(out.Config).UnmarshalEasyJSON(in)
-		case "created_by":
-			if in.IsNull() {
-				in.Skip()
-				out.CreatedBy = nil
-			} else {
-				if out.CreatedBy == nil {
-					out.CreatedBy = new(User)
-				}
-				(*out.CreatedBy).UnmarshalEasyJSON(in)
-			}
-		case "frozen":
-			out.Frozen = bool(in.Bool())
-		case "member_count":
-			out.MemberCount = int(in.Int())
-		case "members":
-			if in.IsNull() {
-				in.Skip()
-				out.Members = nil
-			} else {
-				in.Delim('[')
-				if out.Members == nil {
-					if !in.IsDelim(']') {
-						out.Members = make([]*ChannelMember, 0, 8)
-					} else {
-						out.Members = []*ChannelMember{}
-					}
-				} else {
-					out.Members = (out.Members)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v71 *ChannelMember
-					if in.IsNull() {
-						in.Skip()
-						v71 = nil
-					} else {
-						if v71 == nil {
-							v71 = new(ChannelMember)
-						}
-						(*v71).UnmarshalEasyJSON(in)
-					}
-					out.Members = append(out.Members, v71)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "messages":
-			if in.IsNull() {
-				in.Skip()
-				out.Messages = nil
-			} else {
-				in.Delim('[')
-				if out.Messages == nil {
-					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
-					} else {
-						out.Messages = []*Message{}
-					}
-				} else {
-					out.Messages = (out.Messages)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v72 *Message
-					if in.IsNull() {
-						in.Skip()
-						v72 = nil
-					} else {
-						if v72 == nil {
-							v72 = new(Message)
-						}
-						(*v72).UnmarshalEasyJSON(in)
-					}
-					out.Messages = append(out.Messages, v72)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "read":
+		case "State":
 			if in.IsNull() {
 				in.Skip()
-				out.Read = nil
+				out.State = nil
 			} else {
-				in.Delim('[')
-				if out.Read == nil {
-					if !in.IsDelim(']') {
-						out.Read = make([]*User, 0, 8)
-					} else {
-						out.Read = []*User{}
-					}
-				} else {
-					out.Read = (out.Read)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v73 *User
-					if in.IsNull() {
-						in.Skip()
-						v73 = nil
-					} else {
-						if v73 == nil {
-							v73 = new(User)
-						}
-						(*v73).UnmarshalEasyJSON(in)
-					}
-					out.Read = append(out.Read, v73)
-					in.WantComma()
+				if out.State == nil {
+					out.State = new(ArchiveState)
 				}
-				in.Delim(']')
-			}
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "last_message_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastMessageAt).UnmarshalJSON(data))
+				(*out.State).UnmarshalEasyJSON(in)
 			}
+		case "HardDelete":
+			out.HardDelete = bool(in.Bool())
+		case "PollInterval":
+			out.PollInterval = time.Duration(in.Int64())
+		case "Timeout":
+			out.Timeout = time.Duration(in.Int64())
+		case "DownloadTimeout":
+			out.DownloadTimeout = time.Duration(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4140,151 +15007,71 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(out *jwriter.Writer, in Channel) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo112(out *jwriter.Writer, in ArchiveOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"cid\":"
-		out.RawString(prefix)
-		out.String(string(in.CID))
-	}
-	{
-		const prefix string = ",\"config\":"
-		out.RawString(prefix)
-		(in.Config).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"created_by\":"
-		out.RawString(prefix)
-		if in.CreatedBy == nil {
-			out.RawString("null")
-		} else {
-			(*in.CreatedBy).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"frozen\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Frozen))
-	}
-	{
-		const prefix string = ",\"member_count\":"
-		out.RawString(prefix)
-		out.Int(int(in.MemberCount))
-	}
-	{
-		const prefix string = ",\"members\":"
-		out.RawString(prefix)
-		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v74, v75 := range in.Members {
-				if v74 > 0 {
-					out.RawByte(',')
-				}
-				if v75 == nil {
-					out.RawString("null")
-				} else {
-					(*v75).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+		const prefix string = ",\"State\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-	}
-	{
-		const prefix string = ",\"messages\":"
-		out.RawString(prefix)
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.State == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v76, v77 := range in.Messages {
-				if v76 > 0 {
-					out.RawByte(',')
-				}
-				if v77 == nil {
-					out.RawString("null")
-				} else {
-					(*v77).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+			(*in.State).MarshalEasyJSON(out)
 		}
 	}
 	{
-		const prefix string = ",\"read\":"
+		const prefix string = ",\"HardDelete\":"
 		out.RawString(prefix)
-		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v78, v79 := range in.Read {
-				if v78 > 0 {
-					out.RawByte(',')
-				}
-				if v79 == nil {
-					out.RawString("null")
-				} else {
-					(*v79).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Bool(bool(in.HardDelete))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"PollInterval\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int64(int64(in.PollInterval))
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"Timeout\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.Int64(int64(in.Timeout))
 	}
 	{
-		const prefix string = ",\"last_message_at\":"
+		const prefix string = ",\"DownloadTimeout\":"
 		out.RawString(prefix)
-		out.Raw((in.LastMessageAt).MarshalJSON())
+		out.Int64(int64(in.DownloadTimeout))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Channel) MarshalJSON() ([]byte, error) {
+func (v ArchiveOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo112(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Channel) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(w, v)
+func (v ArchiveOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo112(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Channel) UnmarshalJSON(data []byte) error {
+func (v *ArchiveOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo112(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Channel) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(l, v)
+func (v *ArchiveOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo112(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, out *Attachment) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo113(in *jlexer.Lexer, out *AppSettings) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4302,33 +15089,50 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, ou
 			in.WantComma()
 			continue
 		}
-		for key := range out.ExtraData {
-			delete(out.ExtraData, key)
-		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "author_name":
-			out.AuthorName = string(in.String())
-		case "title":
-			out.Title = string(in.String())
-		case "title_link":
-			out.TitleLink = string(in.String())
-		case "text":
-			out.Text = string(in.String())
-		case "image_url":
-			out.ImageURL = string(in.String())
-		case "thumb_url":
-			out.ThumbURL = string(in.String())
-		case "asset_url":
-			out.AssetURL = string(in.String())
-		case "og_scrape_url":
-			out.OGScrapeURL = string(in.String())
-		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
+		case "webhook_url":
+			if in.IsNull() {
+				in.Skip()
+				out.WebhookURL = nil
+			} else {
+				if out.WebhookURL == nil {
+					out.WebhookURL = new(string)
+				}
+				*out.WebhookURL = string(in.String())
 			}
-			out.ExtraData[key] = in.Interface()
+		case "event_hooks":
+			if in.IsNull() {
+				in.Skip()
+				out.EventHooks = nil
+			} else {
+				in.Delim('[')
+				if out.EventHooks == nil {
+					if !in.IsDelim(']') {
+						out.EventHooks = make([]*EventHook, 0, 8)
+					} else {
+						out.EventHooks = []*EventHook{}
+					}
+				} else {
+					out.EventHooks = (out.EventHooks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v263 *EventHook
+					if in.IsNull() {
+						in.Skip()
+						v263 = nil
+					} else {
+						if v263 == nil {
+							v263 = new(EventHook)
+						}
+						(*v263).UnmarshalEasyJSON(in)
+					}
+					out.EventHooks = append(out.EventHooks, v263)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -4337,139 +15141,154 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(out *jwriter.Writer, in Attachment) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo113(out *jwriter.Writer, in AppSettings) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Type != "" {
-		const prefix string = ",\"type\":"
+	if in.WebhookURL != nil {
+		const prefix string = ",\"webhook_url\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(*in.WebhookURL))
 	}
-	if in.AuthorName != "" {
-		const prefix string = ",\"author_name\":"
+	if len(in.EventHooks) != 0 {
+		const prefix string = ",\"event_hooks\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.AuthorName))
-	}
-	if in.Title != "" {
-		const prefix string = ",\"title\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v264, v265 := range in.EventHooks {
+				if v264 > 0 {
+					out.RawByte(',')
+				}
+				if v265 == nil {
+					out.RawString("null")
+				} else {
+					(*v265).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
-		out.String(string(in.Title))
 	}
-	if in.TitleLink != "" {
-		const prefix string = ",\"title_link\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AppSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo113(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AppSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo113(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AppSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo113(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AppSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo113(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo114(in *jlexer.Lexer, out *AddMemberOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
-		out.String(string(in.TitleLink))
+		in.Skip()
+		return
 	}
-	if in.Text != "" {
-		const prefix string = ",\"text\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
 		}
-		out.String(string(in.Text))
-	}
-	if in.ImageURL != "" {
-		const prefix string = ",\"image_url\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+		switch key {
+		case "HideHistory":
+			out.HideHistory = bool(in.Bool())
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "MemberRole":
+			out.MemberRole = string(in.String())
+		default:
+			in.SkipRecursive()
 		}
-		out.String(string(in.ImageURL))
+		in.WantComma()
 	}
-	if in.ThumbURL != "" {
-		const prefix string = ",\"thumb_url\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ThumbURL))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
-	if in.AssetURL != "" {
-		const prefix string = ",\"asset_url\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.AssetURL))
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo114(out *jwriter.Writer, in AddMemberOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"HideHistory\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HideHistory))
 	}
-	if in.OGScrapeURL != "" {
-		const prefix string = ",\"og_scrape_url\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			(*in.Message).MarshalEasyJSON(out)
 		}
-		out.String(string(in.OGScrapeURL))
 	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "type", "author_name", "title", "title_link", "text", "image_url", "thumb_url", "asset_url", "og_scrape_url":
-			continue // don't allow field overwrites
-		}
-		if first {
-			first = false
-		} else {
-			out.RawByte(',')
-		}
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(v))
-		}
+	{
+		const prefix string = ",\"MemberRole\":"
+		out.RawString(prefix)
+		out.String(string(in.MemberRole))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Attachment) MarshalJSON() ([]byte, error) {
+func (v AddMemberOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo114(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(w, v)
+func (v AddMemberOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo114(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Attachment) UnmarshalJSON(data []byte) error {
+func (v *AddMemberOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo114(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(l, v)
+func (v *AddMemberOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo114(l, v)
 }
@@ -0,0 +1,32 @@
+// Package streamtest provides helpers for testing code built on the chat client without a live Stream app.
+// It's kept apart from the client package, as it depends on net/http/httptest.
+package streamtest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	stream "github.com/GetStream/stream-chat-go"
+)
+
+// ServeWebhook delivers the event, signed by c the same way Stream signs webhook calls,
+// to the webhook handler and returns the recorded response.
+func ServeWebhook(c *stream.Client, h http.Handler, event *stream.Event) (*httptest.ResponseRecorder, error) {
+	switch {
+	case c == nil:
+		return nil, errors.New("client is nil")
+	case h == nil:
+		return nil, errors.New("handler is nil")
+	}
+
+	r, err := c.NewWebhookRequest("/", event)
+	if err != nil {
+		return nil, err
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w, nil
+}
@@ -0,0 +1,38 @@
+package streamtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	stream "github.com/GetStream/stream-chat-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeWebhook(t *testing.T) {
+	c, err := stream.NewClient("key", []byte("secret"))
+	require.NoError(t, err, "new client")
+
+	var got []byte
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err, "read body")
+
+		if !c.VerifyWebhook(body, []byte(r.Header.Get(stream.WebhookSignatureHeader))) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		got = body
+	})
+
+	resp, err := ServeWebhook(c, h, &stream.Event{Type: stream.EventMessageNew, CID: "messaging:fellowship-of-the-ring"})
+	require.NoError(t, err, "serve webhook")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, string(got), `"type":"message.new"`)
+
+	_, err = ServeWebhook(c, nil, &stream.Event{Type: stream.EventMessageNew})
+	assert.Error(t, err, "nil handler")
+}
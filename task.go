@@ -0,0 +1,85 @@
+package stream_chat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusWaiting   TaskStatus = "waiting"
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusRunning   TaskStatus = "running"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusFailed    TaskStatus = "failed"
+)
+
+// Task is an asynchronous server side job, ie users export or deletion
+type Task struct {
+	TaskID string     `json:"task_id"`
+	Status TaskStatus `json:"status"` // one of TaskStatus* constants
+
+	// task specific result, ie {"url": exportURL} for exports
+	Result map[string]interface{} `json:"result"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Done reports whether the task is finished, either completed or failed
+func (t *Task) Done() bool {
+	return t.Status == TaskStatusCompleted || t.Status == TaskStatusFailed
+}
+
+type taskResponse struct {
+	TaskID string `json:"task_id"`
+}
+
+// GetTask returns current state of the asynchronous task
+func (c *Client) GetTask(taskID string) (*Task, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID is empty")
+	}
+
+	p := buildPath("tasks", taskID)
+
+	var task Task
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// WaitForTask polls the task state every pollInterval until the task is done or timeout passes.
+// Failed task is returned along with an error.
+func (c *Client) WaitForTask(taskID string, pollInterval time.Duration, timeout time.Duration) (*Task, error) {
+	if pollInterval <= 0 {
+		return nil, errors.New("poll interval must be positive")
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		task, err := c.GetTask(taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case task.Status == TaskStatusFailed:
+			return task, fmt.Errorf("task %s failed: %v", taskID, task.Result)
+		case task.Done():
+			return task, nil
+		case time.Now().Add(pollInterval).After(deadline):
+			return task, fmt.Errorf("task %s is not done after %s, status %s", taskID, timeout, task.Status)
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
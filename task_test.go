@@ -0,0 +1,26 @@
+package stream_chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ExportUsers(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+	_, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert users")
+
+	taskID, err := c.ExportUsers(user.ID)
+	mustNoError(t, err, "export users")
+	assert.NotEmpty(t, taskID, "task ID")
+
+	task, err := c.WaitForTask(taskID, time.Second, time.Minute)
+	mustNoError(t, err, "wait for task")
+
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+	assert.NotEmpty(t, task.Result["url"], "export url")
+}
@@ -0,0 +1,79 @@
+package stream_chat
+
+import (
+	"sync"
+	"time"
+)
+
+// ThrottleProfile limits how fast bulk helpers (UpsertUsers, RemoveReactionsOfType, ...) send requests.
+type ThrottleProfile struct {
+	Concurrency int           // max number of requests in flight, values below 1 mean 1
+	Interval    time.Duration // min delay between starting two requests
+}
+
+var (
+	// ThrottleConservative sends requests one by one, at most 60 per minute
+	ThrottleConservative = ThrottleProfile{Concurrency: 1, Interval: time.Second}
+	// ThrottleNormal sends up to 4 requests at once, at most 600 per minute
+	ThrottleNormal = ThrottleProfile{Concurrency: 4, Interval: 100 * time.Millisecond}
+	// ThrottleAggressive sends up to 10 requests at once, at most 1500 per minute
+	ThrottleAggressive = ThrottleProfile{Concurrency: 10, Interval: 40 * time.Millisecond}
+)
+
+// run calls fn for every index in [0, n) within profile limits.
+// No new calls are started once a call fails, the first error is returned.
+func (p ThrottleProfile) run(n int, fn func(i int) error) error {
+	concurrency := p.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		started  time.Time
+	)
+
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		if wait := p.Interval - time.Since(started); !started.IsZero() && wait > 0 {
+			time.Sleep(wait)
+		}
+
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+
+		started = time.Now()
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(i); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
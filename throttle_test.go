@@ -0,0 +1,71 @@
+package stream_chat
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottleProfile_run(t *testing.T) {
+	t.Run("calls every index within concurrency", func(t *testing.T) {
+		p := ThrottleProfile{Concurrency: 3}
+
+		var (
+			mu          sync.Mutex
+			inFlight    int
+			maxInFlight int
+			seen        = map[int]bool{}
+		)
+
+		err := p.run(20, func(i int) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			seen[i] = true
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		})
+		mustNoError(t, err, "run")
+
+		assert.Len(t, seen, 20, "all indexes are called")
+		assert.True(t, maxInFlight <= 3, "concurrency is limited, got %d", maxInFlight)
+	})
+
+	t.Run("keeps interval between calls", func(t *testing.T) {
+		p := ThrottleProfile{Concurrency: 5, Interval: 10 * time.Millisecond}
+
+		start := time.Now()
+		err := p.run(4, func(i int) error { return nil })
+		mustNoError(t, err, "run")
+
+		assert.True(t, time.Since(start) >= 30*time.Millisecond, "interval is respected")
+	})
+
+	t.Run("stops after error", func(t *testing.T) {
+		p := ThrottleProfile{Concurrency: 1}
+		wantErr := errors.New("boom")
+
+		var calls int
+		err := p.run(10, func(i int) error {
+			calls++
+			if i == 2 {
+				return wantErr
+			}
+			return nil
+		})
+
+		assert.Equal(t, wantErr, err)
+		assert.Equal(t, 3, calls, "no calls after error")
+	})
+}
@@ -1,18 +1,22 @@
 package stream_chat
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
-	"path"
+	"strings"
+	"sync"
 	"time"
 )
 
 type Mute struct {
-	User      User
-	Target    User
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	User      User       `json:"user"`
+	Target    User       `json:"target"`
+	Expires   *time.Time `json:"expires,omitempty"` // nil for mutes without timeout
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
 }
 
 type User struct {
@@ -21,10 +25,14 @@ type User struct {
 	Image string `json:"image"`
 	Role  string `json:"role"`
 
-	Online    bool `json:"online"`
-	Invisible bool `json:"invisible"`
+	Online    bool `json:"online"`    // set by the server, presence of invisible users is always offline
+	Invisible bool `json:"invisible"` // the user appears offline to others while connected
 
-	Mutes []*Mute `json:"mutes"`
+	Teams     []string          `json:"teams,omitempty"`      // teams the user belongs to in multi-tenant apps
+	TeamsRole map[string]string `json:"teams_role,omitempty"` // user's role per team, keyed by team
+
+	Mutes        []*Mute        `json:"mutes"`
+	ChannelMutes []*ChannelMute `json:"channel_mutes"`
 
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
@@ -33,15 +41,33 @@ type User struct {
 	ExtraData map[string]interface{} `json:"-,extra"`
 }
 
-// Create a mute
+// NewUser returns a user with the normalized ID: trimmed and lowercased.
+// Returns an error if the ID is not valid, see ValidateUserID.
+func NewUser(id string) (*User, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+
+	if err := ValidateUserID(id); err != nil {
+		return nil, err
+	}
+
+	return &User{ID: id}, nil
+}
+
+type muteResponse struct {
+	Mute  *Mute   `json:"mute"`
+	Mutes []*Mute `json:"mutes"`
+}
+
+// MuteUser creates a mute
 // targetID: the user getting muted
 // userID: the user muting the target
-func (c *Client) MuteUser(targetID string, userID string) error {
+// options: additional mute options, ie {"timeout": 60} to expire the mute in 60 minutes
+func (c *Client) MuteUser(targetID string, userID string, options map[string]interface{}) (*Mute, error) {
 	switch {
 	case targetID == "":
-		return errors.New("target ID is empty")
+		return nil, errors.New("target ID is empty")
 	case userID == "":
-		return errors.New("user ID is empty")
+		return nil, errors.New("user ID is empty")
 	}
 
 	data := map[string]interface{}{
@@ -49,10 +75,46 @@ func (c *Client) MuteUser(targetID string, userID string) error {
 		"user_id":   userID,
 	}
 
-	return c.makeRequest(http.MethodPost, "moderation/mute", nil, data, nil)
+	for k, v := range options {
+		data[k] = v
+	}
+
+	var resp muteResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/mute", nil, data, &resp)
+
+	return resp.Mute, err
+}
+
+// MuteUsers mutes all targets at once
+// targetIDs: the users getting muted
+// userID: the user muting the targets
+// options: additional mute options, ie {"timeout": 60} to expire the mutes in 60 minutes
+func (c *Client) MuteUsers(targetIDs []string, userID string, options map[string]interface{}) ([]*Mute, error) {
+	switch {
+	case len(targetIDs) == 0:
+		return nil, errors.New("target IDs are empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"target_ids": targetIDs,
+		"user_id":    userID,
+	}
+
+	for k, v := range options {
+		data[k] = v
+	}
+
+	var resp muteResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/mute", nil, data, &resp)
+
+	return resp.Mutes, err
 }
 
-// Removes a mute
+// UnmuteUser removes a mute
 // targetID: the user getting un-muted
 // userID: the user muting the target
 func (c *Client) UnmuteUser(targetID string, userID string) error {
@@ -71,32 +133,54 @@ func (c *Client) UnmuteUser(targetID string, userID string) error {
 	return c.makeRequest(http.MethodPost, "moderation/unmute", nil, data, nil)
 }
 
-func (c *Client) FlagUser(targetID string, options map[string]interface{}) error {
+// FlagUser flags target user ID for review in the moderation queue
+// userID: user who flags the target
+// options: additional flag options, ie {"reason": "spam", "custom": {"score": 0.9}}
+func (c *Client) FlagUser(targetID string, userID string, options map[string]interface{}) error {
 	switch {
 	case targetID == "":
 		return errors.New("target ID is empty")
-	case len(options) == 0:
-		return errors.New("flag user: options must be not empty")
+	case userID == "":
+		return errors.New("user ID is empty")
 	}
 
-	options["target_user_id"] = targetID
+	data := map[string]interface{}{
+		"target_user_id": targetID,
+		"user_id":        userID,
+	}
 
-	return c.makeRequest(http.MethodPost, "moderation/flag", nil, options, nil)
+	for k, v := range options {
+		data[k] = v
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/flag", nil, data, nil)
 }
 
-func (c *Client) UnFlagUser(targetID string, options map[string]interface{}) error {
+// UnFlagUser removes the flag userID raised on target user ID
+func (c *Client) UnFlagUser(targetID string, userID string) error {
 	switch {
 	case targetID == "":
 		return errors.New("target ID is empty")
-	case options == nil:
-		options = map[string]interface{}{}
+	case userID == "":
+		return errors.New("user ID is empty")
 	}
 
-	options["target_user_id"] = targetID
+	data := map[string]interface{}{
+		"target_user_id": targetID,
+		"user_id":        userID,
+	}
 
-	return c.makeRequest(http.MethodPost, "moderation/unflag", nil, options, nil)
+	return c.makeRequest(http.MethodPost, "moderation/unflag", nil, data, nil)
 }
 
+// BanUser bans target user ID on the whole app, or in a single channel, see Channel.BanUser
+// userID: user who bans target, stored as banned_by_id
+// options: additional ban options, ie {"timeout": 60, "reason": "spam", "ip_ban": true}
+// timeout is the ban duration in minutes, without it the ban never expires;
+// ip_ban also bans the last IP address the target user connected from;
+// {"type": channelType, "id": channelID} limits the ban to the channel;
+// {"data": {"policy_id": "harassment-2", "evidence_url": "https://..."}} stores custom data
+// with the ban, returned by QueryBannedUsers
 func (c *Client) BanUser(targetID string, userID string, options map[string]interface{}) error {
 	switch {
 	case targetID == "":
@@ -109,10 +193,27 @@ func (c *Client) BanUser(targetID string, userID string, options map[string]inte
 
 	options["target_user_id"] = targetID
 	options["user_id"] = userID
+	options["banned_by_id"] = userID
 
 	return c.makeRequest(http.MethodPost, "moderation/ban", nil, options, nil)
 }
 
+// ShadowBan bans target user ID without notifying them: the user can still send messages,
+// but the messages are only visible to them
+// userID: user who bans target
+// options: additional ban options, same as for BanUser
+func (c *Client) ShadowBan(targetID string, userID string, options map[string]interface{}) error {
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+
+	options["shadow"] = true
+
+	return c.BanUser(targetID, userID, options)
+}
+
+// UnBanUser removes the ban of target user ID
+// options: additional unban options, ie {"type": channelType, "id": channelID} to remove a channel ban
 func (c *Client) UnBanUser(targetID string, options map[string]string) error {
 	switch {
 	case targetID == "":
@@ -132,38 +233,155 @@ func (c *Client) UnBanUser(targetID string, options map[string]string) error {
 	return c.makeRequest(http.MethodDelete, "moderation/ban", params, nil, nil)
 }
 
-func (c *Client) ExportUser(targetID string, options map[string][]string) (user *User, err error) {
+// Ban is a ban of a user on the whole app or in a channel
+type Ban struct {
+	User     *User      `json:"user"`
+	BannedBy *User      `json:"banned_by,omitempty"`
+	Channel  *Channel   `json:"channel,omitempty"` // nil for app-wide bans
+	Reason   string     `json:"reason,omitempty"`
+	Shadow   bool       `json:"shadow,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"` // nil for bans without timeout
+
+	// Data is the custom data set with BanUser options, ie policy ID or evidence link
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type queryBannedUsersRequest struct {
+	FilterConditions map[string]interface{} `json:"filter_conditions,omitempty"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+type queryBannedUsersResponse struct {
+	Bans []*Ban `json:"bans"`
+}
+
+// QueryBannedUsers returns bans that match QueryOption,
+// ie {"channel_cid": "livestream:main"} for the bans of a channel.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
+func (c *Client) QueryBannedUsers(q *QueryOption, sort ...*SortOption) ([]*Ban, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	req := queryBannedUsersRequest{
+		FilterConditions: q.Filter,
+		Sort:             sort,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"payload": {string(payload)}}
+
+	var resp queryBannedUsersResponse
+
+	err = c.makeRequest(http.MethodGet, "query_banned_users", params, nil, &resp)
+
+	return resp.Bans, err
+}
+
+type ExportUserResponse struct {
+	User      *User       `json:"user"`
+	Messages  []*Message  `json:"messages"`
+	Reactions []*Reaction `json:"reactions"`
+}
+
+// ExportUser returns the user's profile along with all messages and reactions of the user
+func (c *Client) ExportUser(targetID string, options map[string][]string) (*ExportUserResponse, error) {
 	if targetID == "" {
-		return user, errors.New("target ID is empty")
+		return nil, errors.New("target ID is empty")
 	}
 
-	p := path.Join("users", url.PathEscape(targetID), "export")
+	p := buildPath("users", targetID, "export")
 
-	err = c.makeRequest(http.MethodGet, p, options, nil, user)
+	var resp ExportUserResponse
+
+	err := c.makeRequest(http.MethodGet, p, options, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
 
-	return user, err
+	return &resp, nil
 }
 
+// DeactivateUser deactivates the user, deactivated user can't connect or make API calls.
+// options: additional deactivate options, ie {"mark_messages_deleted": true, "created_by_id": moderatorID}
 func (c *Client) DeactivateUser(targetID string, options map[string]interface{}) error {
 	if targetID == "" {
 		return errors.New("target ID is empty")
 	}
 
-	p := path.Join("users", url.PathEscape(targetID), "deactivate")
+	p := buildPath("users", targetID, "deactivate")
+
+	return c.makeRequest(http.MethodPost, p, nil, options, nil)
+}
+
+// ReactivateUser reactivates previously deactivated user.
+// options: additional reactivate options, ie {"restore_messages": true, "name": newName, "created_by_id": moderatorID}
+func (c *Client) ReactivateUser(targetID string, options map[string]interface{}) error {
+	if targetID == "" {
+		return errors.New("target ID is empty")
+	}
+
+	p := buildPath("users", targetID, "reactivate")
 
 	return c.makeRequest(http.MethodPost, p, nil, options, nil)
 }
 
+// DeactivateUsers schedules asynchronous deactivation of up to 100 users, returns ID of the task.
+// options: additional deactivate options, ie {"mark_messages_deleted": true, "created_by_id": moderatorID}
+func (c *Client) DeactivateUsers(userIDs []string, options map[string]interface{}) (string, error) {
+	return c.usersTask("users/deactivate", userIDs, options)
+}
+
+// ReactivateUsers schedules asynchronous reactivation of up to 100 users, returns ID of the task.
+// options: additional reactivate options, ie {"restore_messages": true, "created_by_id": moderatorID}
+func (c *Client) ReactivateUsers(userIDs []string, options map[string]interface{}) (string, error) {
+	return c.usersTask("users/reactivate", userIDs, options)
+}
+
+func (c *Client) usersTask(p string, userIDs []string, options map[string]interface{}) (string, error) {
+	switch {
+	case len(userIDs) == 0:
+		return "", errors.New("user IDs are empty")
+	case len(userIDs) > maxUsersPerRequest:
+		return "", fmt.Errorf("too many user IDs: %d, max is %d", len(userIDs), maxUsersPerRequest)
+	}
+
+	data := make(map[string]interface{}, len(options)+1)
+	for k, v := range options {
+		data[k] = v
+	}
+	data["user_ids"] = userIDs
+
+	var resp taskResponse
+
+	err := c.makeRequest(http.MethodPost, p, nil, data, &resp)
+
+	return resp.TaskID, err
+}
+
 func (c *Client) DeleteUser(targetID string, options map[string][]string) error {
 	if targetID == "" {
 		return errors.New("target ID is empty")
 	}
 
-	p := path.Join("users", url.PathEscape(targetID))
+	p := buildPath("users", targetID)
 
 	return c.makeRequest(http.MethodDelete, p, options, nil, nil)
 }
 
+const maxUsersPerRequest = 100
+
 type usersResponse struct {
 	Users map[string]*User `json:"users"`
 }
@@ -175,19 +393,101 @@ type usersRequest struct {
 type userRequest struct {
 	*User
 	// readonly fields
-	CreatedAt  time.Time `json:"-"`
-	UpdatedAt  time.Time `json:"-"`
-	LastActive time.Time `json:"-"`
+	Online       bool           `json:"-"`
+	ChannelMutes []*ChannelMute `json:"-"`
+	CreatedAt    time.Time      `json:"-"`
+	UpdatedAt    time.Time      `json:"-"`
+	LastActive   time.Time      `json:"-"`
+}
+
+type usersImportRequest struct {
+	Users map[string]userImportRequest `json:"users"`
+}
+
+type userImportRequest struct {
+	*User
+	// readonly fields
+	Online       bool           `json:"-"`
+	ChannelMutes []*ChannelMute `json:"-"`
+	UpdatedAt    time.Time      `json:"-"`
+
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	LastActive *time.Time `json:"last_active,omitempty"`
+}
+
+func newUserImportRequest(u *User) userImportRequest {
+	req := userImportRequest{User: u}
+	if !u.CreatedAt.IsZero() {
+		req.CreatedAt = &u.CreatedAt
+	}
+	if !u.LastActive.IsZero() {
+		req.LastActive = &u.LastActive
+	}
+	return req
 }
 
 // UpdateUsers send update users request, returns updated user info
+//
+// Deprecated: use UpsertUsers, which also splits large user lists into batches.
 func (c *Client) UpdateUsers(users ...*User) (map[string]*User, error) {
+	return c.UpsertUsers(users...)
+}
+
+// UpsertUsers creates or updates given users, returns stored users info keyed by user ID.
+// Users are sent in batches of up to 100 users per request, batches are throttled by client's BulkThrottle.
+// If a batch fails, users stored by the other batches are returned along with the error.
+func (c *Client) UpsertUsers(users ...*User) (map[string]*User, error) {
+	return c.inBatches(users, c.upsertUsers)
+}
+
+// ImportUsers works like UpsertUsers, but keeps users' CreatedAt and LastActive values when they are set,
+// so migrated accounts retain their original history. Requires historical imports to be allowed for the app.
+func (c *Client) ImportUsers(users ...*User) (map[string]*User, error) {
+	return c.inBatches(users, c.importUsers)
+}
+
+// inBatches sends users in batches of maxUsersPerRequest using send, throttled by client's BulkThrottle
+func (c *Client) inBatches(users []*User, send func([]*User) (map[string]*User, error)) (map[string]*User, error) {
 	if len(users) == 0 {
 		return nil, errors.New("users are not set")
 	}
 
+	var mu sync.Mutex
+	result := make(map[string]*User, len(users))
+
+	batches := (len(users) + maxUsersPerRequest - 1) / maxUsersPerRequest
+
+	err := c.BulkThrottle.run(batches, func(i int) error {
+		start := i * maxUsersPerRequest
+		end := start + maxUsersPerRequest
+		if end > len(users) {
+			end = len(users)
+		}
+
+		stored, err := send(users[start:end])
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for id, u := range stored {
+			result[id] = u
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *Client) upsertUsers(users []*User) (map[string]*User, error) {
 	req := usersRequest{Users: make(map[string]userRequest, len(users))}
 	for _, u := range users {
+		if u == nil {
+			return nil, errors.New("user is nil")
+		}
 		req.Users[u.ID] = userRequest{User: u}
 	}
 
@@ -198,5 +498,215 @@ func (c *Client) UpdateUsers(users ...*User) (map[string]*User, error) {
 		return nil, err
 	}
 
-	return resp.Users, err
+	return resp.Users, nil
+}
+
+func (c *Client) importUsers(users []*User) (map[string]*User, error) {
+	req := usersImportRequest{Users: make(map[string]userImportRequest, len(users))}
+	for _, u := range users {
+		if u == nil {
+			return nil, errors.New("user is nil")
+		}
+		req.Users[u.ID] = newUserImportRequest(u)
+	}
+
+	var resp usersResponse
+
+	err := c.makeRequest(http.MethodPost, "users", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Users, nil
+}
+
+// PartialUserUpdate describes changes of a single user's fields,
+// fields in Set are overwritten, fields in Unset are removed
+type PartialUserUpdate struct {
+	ID    string                 `json:"id"`
+	Set   map[string]interface{} `json:"set,omitempty"`
+	Unset []string               `json:"unset,omitempty"`
+}
+
+type partialUsersRequest struct {
+	Users []PartialUserUpdate `json:"users"`
+}
+
+// PartialUpdateUsers applies partial updates to up to 100 users in a single request,
+// returns updated users info keyed by user ID
+func (c *Client) PartialUpdateUsers(updates ...PartialUserUpdate) (map[string]*User, error) {
+	switch {
+	case len(updates) == 0:
+		return nil, errors.New("updates are not set")
+	case len(updates) > maxUsersPerRequest:
+		return nil, fmt.Errorf("too many updates: %d, max is %d", len(updates), maxUsersPerRequest)
+	}
+
+	for _, u := range updates {
+		if u.ID == "" {
+			return nil, errors.New("user ID is empty")
+		}
+	}
+
+	var resp usersResponse
+
+	err := c.makeRequest(http.MethodPatch, "users", nil, partialUsersRequest{Users: updates}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Users, nil
+}
+
+// SetInvisible sets whether the user appears offline to others while connected,
+// ie for support agents monitoring channels
+func (c *Client) SetInvisible(userID string, invisible bool) (*User, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	return c.PartialUpdateUser(PartialUserUpdate{
+		ID:  userID,
+		Set: map[string]interface{}{"invisible": invisible},
+	})
+}
+
+// PartialUpdateUser applies partial update to a single user, returns updated user info
+func (c *Client) PartialUpdateUser(update PartialUserUpdate) (*User, error) {
+	users, err := c.PartialUpdateUsers(update)
+	if err != nil {
+		return nil, err
+	}
+
+	return users[update.ID], nil
+}
+
+type DeleteType string
+
+const (
+	DeleteTypeSoft DeleteType = "soft"
+	DeleteTypeHard DeleteType = "hard"
+)
+
+type DeleteUsersOptions struct {
+	User          DeleteType // how to delete the users, required
+	Messages      DeleteType // how to delete users' messages, empty keeps them
+	Conversations DeleteType // how to delete users' 1:1 channels, empty keeps them
+}
+
+type deleteUsersRequest struct {
+	UserIDs       []string   `json:"user_ids"`
+	User          DeleteType `json:"user"`
+	Messages      DeleteType `json:"messages,omitempty"`
+	Conversations DeleteType `json:"conversations,omitempty"`
+}
+
+// DeleteUsers schedules asynchronous deletion of up to 100 users, returns ID of the deletion task
+func (c *Client) DeleteUsers(userIDs []string, options DeleteUsersOptions) (string, error) {
+	switch {
+	case len(userIDs) == 0:
+		return "", errors.New("user IDs are empty")
+	case len(userIDs) > maxUsersPerRequest:
+		return "", fmt.Errorf("too many user IDs: %d, max is %d", len(userIDs), maxUsersPerRequest)
+	case options.User == "":
+		return "", errors.New("user delete type is empty")
+	}
+
+	req := deleteUsersRequest{
+		UserIDs:       userIDs,
+		User:          options.User,
+		Messages:      options.Messages,
+		Conversations: options.Conversations,
+	}
+
+	var resp taskResponse
+
+	err := c.makeRequest(http.MethodPost, "users/delete", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+type exportUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// ExportUsers schedules asynchronous export of up to 100 users, returns ID of the export task.
+// Once the task is completed, its result contains the URL of the exported data.
+func (c *Client) ExportUsers(userIDs ...string) (string, error) {
+	switch {
+	case len(userIDs) == 0:
+		return "", errors.New("user IDs are empty")
+	case len(userIDs) > maxUsersPerRequest:
+		return "", fmt.Errorf("too many user IDs: %d, max is %d", len(userIDs), maxUsersPerRequest)
+	}
+
+	var resp taskResponse
+
+	err := c.makeRequest(http.MethodPost, "export/users", nil, exportUsersRequest{UserIDs: userIDs}, &resp)
+
+	return resp.TaskID, err
+}
+
+type BlockedUser struct {
+	UserID        string `json:"user_id"`
+	BlockedUserID string `json:"blocked_user_id"`
+	User          *User  `json:"user"`
+	BlockedUser   *User  `json:"blocked_user"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type blockedUsersResponse struct {
+	Blocks []*BlockedUser `json:"blocks"`
+}
+
+// BlockUser blocks target user on behalf of user with given ID
+func (c *Client) BlockUser(targetID string, userID string) error {
+	switch {
+	case targetID == "":
+		return errors.New("target ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"blocked_user_id": targetID,
+		"user_id":         userID,
+	}
+
+	return c.makeRequest(http.MethodPost, "users/block", nil, data, nil)
+}
+
+// UnblockUser removes the block of target user made by user with given ID
+func (c *Client) UnblockUser(targetID string, userID string) error {
+	switch {
+	case targetID == "":
+		return errors.New("target ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"blocked_user_id": targetID,
+		"user_id":         userID,
+	}
+
+	return c.makeRequest(http.MethodPost, "users/unblock", nil, data, nil)
+}
+
+// GetBlockedUsers returns users blocked by user with given ID
+func (c *Client) GetBlockedUsers(userID string) ([]*BlockedUser, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	params := map[string][]string{
+		"user_id": {userID},
+	}
+
+	var resp blockedUsersResponse
+
+	err := c.makeRequest(http.MethodGet, "users/block", params, nil, &resp)
+
+	return resp.Blocks, err
 }
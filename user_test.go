@@ -1,37 +1,152 @@
 package stream_chat
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/getstream/easyjson"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_BanUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+	defer c.UnBanUser(user.ID, nil)
+
+	err := c.BanUser(user.ID, serverUser.ID, map[string]interface{}{
+		"timeout": 60,
+		"reason":  "offensive language is not allowed here",
+		"ip_ban":  true,
+	})
+	mustNoError(t, err, "ban user")
 
+	err = c.ShadowBan(user.ID, serverUser.ID, nil)
+	mustNoError(t, err, "shadow ban user")
 }
 
 func TestClient_DeactivateUser(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(12), Name: "Gollum"}
+	_, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert users")
 
+	err = c.DeactivateUser(user.ID, map[string]interface{}{
+		"mark_messages_deleted": true,
+		"created_by_id":         serverUser.ID,
+	})
+	mustNoError(t, err, "deactivate user")
+
+	err = c.ReactivateUser(user.ID, map[string]interface{}{
+		"restore_messages": true,
+		"name":             "Smeagol",
+	})
+	mustNoError(t, err, "reactivate user")
 }
 
 func TestClient_DeleteUser(t *testing.T) {
 }
 
-func TestClient_ExportUser(t *testing.T) {}
+func TestClient_ExportUser(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	_, err = ch.SendReaction(&Reaction{Type: "love"}, msg.ID, user.ID)
+	mustNoError(t, err, "send reaction")
+
+	resp, err := c.ExportUser(user.ID, nil)
+	mustNoError(t, err, "export user")
+
+	assert.Equal(t, user.ID, resp.User.ID)
+	assert.NotEmpty(t, resp.Messages, "messages are exported")
+	assert.NotEmpty(t, resp.Reactions, "reactions are exported")
+}
 
 func TestClient_FlagUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	err := c.FlagUser(user.ID, serverUser.ID, map[string]interface{}{
+		"reason": "spam",
+		"custom": map[string]interface{}{"score": 0.9},
+	})
+	mustNoError(t, err, "flag user")
 }
 
 func TestClient_MuteUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	mute, err := c.MuteUser(user.ID, serverUser.ID, nil)
+	mustNoError(t, err, "mute user")
+
+	assert.Equal(t, user.ID, mute.Target.ID)
+	assert.Nil(t, mute.Expires, "mute without timeout doesn't expire")
+
+	mute, err = c.MuteUser(user.ID, serverUser.ID, map[string]interface{}{"timeout": 60})
+	mustNoError(t, err, "mute user with timeout")
+
+	if assert.NotNil(t, mute.Expires, "mute with timeout expires") {
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *mute.Expires, time.Minute)
+	}
+}
+
+func TestClient_MuteUsers(t *testing.T) {
+	c := initClient(t)
+
+	users := randomUsers(2)
+	targetIDs := []string{users[0].ID, users[1].ID}
+
+	mutes, err := c.MuteUsers(targetIDs, serverUser.ID, map[string]interface{}{"timeout": 60})
+	mustNoError(t, err, "mute users")
+
+	assert.Len(t, mutes, len(targetIDs))
 }
 
 func TestClient_UnBanUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	err := c.BanUser(user.ID, serverUser.ID, nil)
+	mustNoError(t, err, "ban user")
+
+	err = c.UnBanUser(user.ID, nil)
+	mustNoError(t, err, "unban user")
 }
 
 func TestClient_UnFlagUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	err := c.FlagUser(user.ID, serverUser.ID, nil)
+	mustNoError(t, err, "flag user")
+
+	err = c.UnFlagUser(user.ID, serverUser.ID)
+	mustNoError(t, err, "unflag user")
 }
 
 func TestClient_UnmuteUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	_, err := c.MuteUser(user.ID, serverUser.ID, nil)
+	mustNoError(t, err, "mute user")
+
+	err = c.UnmuteUser(user.ID, serverUser.ID)
+	mustNoError(t, err, "unmute user")
 }
 
 func TestClient_UpdateUsers(t *testing.T) {
@@ -45,3 +160,216 @@ func TestClient_UpdateUsers(t *testing.T) {
 	assert.NotEmpty(t, resp[user.ID].CreatedAt)
 	assert.NotEmpty(t, resp[user.ID].UpdatedAt)
 }
+
+func TestClient_UpsertUsers(t *testing.T) {
+	c := initClient(t)
+
+	users := make([]*User, 0, maxUsersPerRequest+1)
+	for i := 0; i < cap(users); i++ {
+		users = append(users, &User{ID: randomString(12), Name: "Hobbit"})
+	}
+
+	resp, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+
+	assert.Len(t, resp, len(users), "all users are stored")
+	for _, u := range users {
+		if assert.Contains(t, resp, u.ID) {
+			assert.NotEmpty(t, resp[u.ID].CreatedAt)
+		}
+	}
+}
+
+func TestClient_PartialUpdateUsers(t *testing.T) {
+	c := initClient(t)
+
+	users := []*User{
+		{ID: randomString(12), ExtraData: map[string]interface{}{"color": "red", "age": 30}},
+		{ID: randomString(12), ExtraData: map[string]interface{}{"color": "blue", "age": 40}},
+	}
+	_, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+
+	updates := make([]PartialUserUpdate, 0, len(users))
+	for _, u := range users {
+		updates = append(updates, PartialUserUpdate{
+			ID:    u.ID,
+			Set:   map[string]interface{}{"color": "green"},
+			Unset: []string{"age"},
+		})
+	}
+
+	got, err := c.PartialUpdateUsers(updates...)
+	mustNoError(t, err, "partial update users")
+
+	for _, u := range users {
+		if assert.Contains(t, got, u.ID) {
+			assert.Equal(t, "green", got[u.ID].ExtraData["color"])
+			assert.NotContains(t, got[u.ID].ExtraData, "age")
+		}
+	}
+
+	_, err = c.PartialUpdateUsers(make([]PartialUserUpdate, maxUsersPerRequest+1)...)
+	mustError(t, err, "too many updates")
+}
+
+func TestClient_DeleteUsers(t *testing.T) {
+	c := initClient(t)
+
+	users := []*User{{ID: randomString(12)}, {ID: randomString(12)}}
+	_, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+
+	taskID, err := c.DeleteUsers([]string{users[0].ID, users[1].ID}, DeleteUsersOptions{
+		User:     DeleteTypeHard,
+		Messages: DeleteTypeHard,
+	})
+	mustNoError(t, err, "delete users")
+
+	assert.NotEmpty(t, taskID, "task ID")
+}
+
+func TestClient_ImportUsers(t *testing.T) {
+	c := initClient(t)
+
+	joined := time.Date(2015, time.March, 1, 12, 0, 0, 0, time.UTC)
+	user := &User{ID: randomString(12), Name: "Bilbo Baggins", CreatedAt: joined}
+
+	resp, err := c.ImportUsers(user)
+	mustNoError(t, err, "import users")
+
+	if assert.Contains(t, resp, user.ID) {
+		assert.True(t, joined.Equal(resp[user.ID].CreatedAt), "created at is kept")
+	}
+}
+
+func Test_newUserImportRequest(t *testing.T) {
+	withTimes := newUserImportRequest(&User{ID: "bilbo", CreatedAt: time.Now(), LastActive: time.Now()})
+	assert.NotNil(t, withTimes.CreatedAt)
+	assert.NotNil(t, withTimes.LastActive)
+
+	withoutTimes := newUserImportRequest(&User{ID: "bilbo"})
+	assert.Nil(t, withoutTimes.CreatedAt, "zero created at is omitted")
+	assert.Nil(t, withoutTimes.LastActive, "zero last active is omitted")
+
+	user := &User{
+		ID:           "bilbo",
+		Online:       true,
+		Invisible:    true,
+		ChannelMutes: []*ChannelMute{{User: &User{ID: "bilbo"}, Channel: &Channel{CID: "messaging:shire"}}},
+	}
+
+	data, err := easyjson.Marshal(newUserImportRequest(user))
+	mustNoError(t, err, "marshal import request")
+
+	var sent map[string]interface{}
+	mustNoError(t, json.Unmarshal(data, &sent), "unmarshal import request")
+
+	assert.Equal(t, "bilbo", sent["id"])
+	assert.NotContains(t, sent, "channel_mutes", "read only fields are not sent")
+	assert.NotContains(t, sent, "online", "read only fields are not sent")
+	assert.Equal(t, true, sent["invisible"], "invisible is the only presence field sent")
+}
+
+func TestClient_BlockUser(t *testing.T) {
+	c := initClient(t)
+
+	user, target := testUsers[0], testUsers[1]
+
+	err := c.BlockUser(target.ID, user.ID)
+	mustNoError(t, err, "block user")
+
+	blocked, err := c.GetBlockedUsers(user.ID)
+	mustNoError(t, err, "get blocked users")
+
+	if assert.Len(t, blocked, 1) {
+		assert.Equal(t, target.ID, blocked[0].BlockedUserID)
+	}
+
+	err = c.UnblockUser(target.ID, user.ID)
+	mustNoError(t, err, "unblock user")
+
+	blocked, err = c.GetBlockedUsers(user.ID)
+	mustNoError(t, err, "get blocked users")
+
+	assert.Empty(t, blocked, "no blocked users")
+}
+
+func TestClient_DeactivateUsers(t *testing.T) {
+	c := initClient(t)
+
+	users := []*User{{ID: randomString(12)}, {ID: randomString(12)}}
+	_, err := c.UpsertUsers(users...)
+	mustNoError(t, err, "upsert users")
+
+	userIDs := []string{users[0].ID, users[1].ID}
+
+	taskID, err := c.DeactivateUsers(userIDs, map[string]interface{}{"mark_messages_deleted": true})
+	mustNoError(t, err, "deactivate users")
+
+	_, err = c.WaitForTask(taskID, time.Second, time.Minute)
+	mustNoError(t, err, "wait for deactivate task")
+
+	taskID, err = c.ReactivateUsers(userIDs, map[string]interface{}{"restore_messages": true})
+	mustNoError(t, err, "reactivate users")
+
+	_, err = c.WaitForTask(taskID, time.Second, time.Minute)
+	mustNoError(t, err, "wait for reactivate task")
+}
+
+func TestClient_QueryBannedUsers(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer ch.Delete(false)
+
+	user := randomUser()
+
+	err := ch.BanUser(user.ID, serverUser.ID, map[string]interface{}{
+		"reason": "spam",
+		"data":   map[string]interface{}{"policy_id": "spam-1"},
+	})
+	mustNoError(t, err, "ban user")
+	defer ch.UnBanUser(user.ID, nil)
+
+	bans, err := c.QueryBannedUsers(&QueryOption{
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+	}, &SortOption{Field: "created_at", Direction: -1})
+	mustNoError(t, err, "query banned users")
+
+	if assert.Len(t, bans, 1) {
+		assert.Equal(t, user.ID, bans[0].User.ID)
+		assert.Equal(t, "spam", bans[0].Reason)
+		assert.Equal(t, "spam-1", bans[0].Data["policy_id"], "custom ban data")
+		assert.Equal(t, ch.CID, bans[0].Channel.CID, "ban is scoped to the channel")
+	}
+}
+
+func TestNewUser(t *testing.T) {
+	u, err := NewUser("  Frodo-Baggins ")
+	mustNoError(t, err, "new user")
+	assert.Equal(t, "frodo-baggins", u.ID, "id is normalized")
+
+	_, err = NewUser("frodo baggins")
+	mustError(t, err, "invalid characters")
+
+	_, err = NewUser(" ")
+	mustError(t, err, "empty id")
+}
+
+func TestClient_SetInvisible(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(12), Invisible: true}
+
+	users, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert invisible user")
+	assert.True(t, users[user.ID].Invisible, "invisible is set on upsert")
+
+	got, err := c.SetInvisible(user.ID, false)
+	mustNoError(t, err, "set invisible")
+	assert.False(t, got.Invisible)
+
+	got, err = c.SetInvisible(user.ID, true)
+	mustNoError(t, err, "set invisible")
+	assert.True(t, got.Invisible)
+}
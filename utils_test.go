@@ -2,6 +2,8 @@ package stream_chat
 
 import (
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -27,6 +29,15 @@ func randomUser() *User {
 	return testUsers[rand.Intn(len(testUsers)-1)]
 }
 
+// randomUsers returns n distinct test users other than serverUser
+func randomUsers(n int) []*User {
+	users := make([]*User, n)
+	for i, j := range rand.Perm(len(testUsers) - 1)[:n] {
+		users[i] = testUsers[j]
+	}
+	return users
+}
+
 func randomString(len int) string {
 	bytes := make([]byte, len)
 	for i := 0; i < len; i++ {
@@ -35,6 +46,21 @@ func randomString(len int) string {
 	return string(bytes)
 }
 
+// newTestClient returns a client sending requests to a test server with the handler,
+// the server is closed when the test ends
+func newTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	return c
+}
+
 func mustNoError(t *testing.T, err error, msgAndArgs ...interface{}) {
 	if !assert.NoError(t, err, msgAndArgs...) {
 		t.FailNow()
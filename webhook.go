@@ -0,0 +1,53 @@
+package stream_chat
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	"github.com/getstream/easyjson"
+)
+
+// WebhookSignatureHeader is the header carrying the HMAC signature of webhook request body
+const WebhookSignatureHeader = "X-Signature"
+
+func (c *Client) webhookSignature(body []byte) []byte {
+	mac := hmac.New(sha256.New, c.apiSecret)
+	_, _ = mac.Write(body)
+
+	sig := make([]byte, hex.EncodedLen(mac.Size()))
+	hex.Encode(sig, mac.Sum(nil))
+
+	return sig
+}
+
+// VerifyWebhook validates that signature is the correct HMAC signature of the webhook body
+func (c *Client) VerifyWebhook(body []byte, signature []byte) bool {
+	return hmac.Equal(c.webhookSignature(body), signature)
+}
+
+// NewWebhookRequest returns a POST request with the event signed the same way Stream signs webhook calls.
+// It's meant for testing webhook handlers without a live Stream app.
+func (c *Client) NewWebhookRequest(webhookURL string, event *Event) (*http.Request, error) {
+	if event == nil {
+		return nil, errors.New("event is nil")
+	}
+
+	body, err := easyjson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(WebhookSignatureHeader, string(c.webhookSignature(body)))
+
+	return r, nil
+}
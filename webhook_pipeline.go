@@ -0,0 +1,87 @@
+package stream_chat
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/getstream/easyjson"
+)
+
+// WebhookStage is a step of WebhookPipeline, ie translation, moderation check or forwarding.
+// Stages may modify the event for the following ones, returning false skips the rest of the pipeline.
+type WebhookStage interface {
+	Process(event *Event) (bool, error)
+}
+
+// WebhookStageFunc is an adapter to use a function as WebhookStage
+type WebhookStageFunc func(event *Event) (bool, error)
+
+func (f WebhookStageFunc) Process(event *Event) (bool, error) {
+	return f(event)
+}
+
+// WebhookPipeline runs message events received by a webhook through stages in order.
+// It's an http.Handler which verifies the signature of webhook requests; events without
+// a message are acknowledged without running the stages.
+type WebhookPipeline struct {
+	client *Client
+	stages []WebhookStage
+}
+
+// NewWebhookPipeline returns a pipeline of the stages, ie
+//
+//	c.NewWebhookPipeline(translate, moderate, forward)
+//
+// where forward only sees messages moderate let through.
+func (c *Client) NewWebhookPipeline(stages ...WebhookStage) *WebhookPipeline {
+	return &WebhookPipeline{client: c, stages: stages}
+}
+
+// Process runs the message event through the stages, stopping at the first stage which
+// returns false or an error
+func (p *WebhookPipeline) Process(event *Event) error {
+	switch {
+	case event == nil:
+		return errors.New("event is nil")
+	case event.Message == nil:
+		return nil
+	}
+
+	for _, s := range p.stages {
+		next, err := s.Process(event)
+		if err != nil || !next {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP verifies and decodes the webhook request and processes the event.
+// Stage errors are answered with 500 so Stream retries the delivery.
+func (p *WebhookPipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !p.client.VerifyWebhook(body, []byte(r.Header.Get(WebhookSignatureHeader))) {
+		http.Error(w, "invalid webhook signature", http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := easyjson.Unmarshal(body, &event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := p.Process(&event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,77 @@
+package stream_chat
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_VerifyWebhook(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	body := []byte(`{"type":"message.new"}`)
+	// echo -n '{"type":"message.new"}' | openssl dgst -sha256 -hmac secret
+	signature := []byte("9639707553946e47447c6b6e8baf14cd9793648e82714e66b6eca57a5c3dd78b")
+
+	assert.True(t, c.VerifyWebhook(body, signature), "signature is valid")
+	assert.False(t, c.VerifyWebhook([]byte(`{"type":"message.updated"}`), signature), "signature of other body is invalid")
+	assert.False(t, c.VerifyWebhook(body, []byte("invalid")), "invalid signature")
+}
+
+// serveWebhook delivers the signed event to the webhook handler and returns the recorded response
+func serveWebhook(t *testing.T, c *Client, h http.Handler, event *Event) *httptest.ResponseRecorder {
+	r, err := c.NewWebhookRequest("/", event)
+	mustNoError(t, err, "new webhook request")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestWebhookPipeline(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+
+	var forwarded []string
+
+	translate := WebhookStageFunc(func(e *Event) (bool, error) {
+		e.Message.Text = strings.ToUpper(e.Message.Text)
+		return true, nil
+	})
+	moderate := WebhookStageFunc(func(e *Event) (bool, error) {
+		return !strings.Contains(e.Message.Text, "SPAM"), nil
+	})
+	forward := WebhookStageFunc(func(e *Event) (bool, error) {
+		forwarded = append(forwarded, e.Message.Text)
+		return true, nil
+	})
+
+	p := c.NewWebhookPipeline(translate, moderate, forward)
+
+	for _, text := range []string{"hello", "buy spam"} {
+		w := serveWebhook(t, c, p, &Event{Type: EventMessageNew, Message: &Message{Text: text}})
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+
+	w := serveWebhook(t, c, p, &Event{Type: EventUserUpdated})
+	assert.Equal(t, http.StatusOK, w.Code, "events without message are acknowledged")
+
+	assert.Equal(t, []string{"HELLO"}, forwarded, "moderated message is not forwarded")
+
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"type":"message.new"}`))
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "unsigned request is rejected")
+
+	failing := c.NewWebhookPipeline(WebhookStageFunc(func(*Event) (bool, error) {
+		return false, errors.New("translation service is down")
+	}))
+	w = serveWebhook(t, c, failing, &Event{Type: EventMessageNew, Message: &Message{Text: "hello"}})
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "stage error makes Stream retry")
+}